@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command import-rhcos-image imports an RHCOS qcow2 already uploaded to OSS as a custom ECS
+// image, waits for it to become available, and tags it with the cluster ID, so a new region can
+// be given a usable image without hand-driving the ECS console. See pkg/images for what it
+// assumes about the upload having already happened.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/apis"
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/images"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+func main() {
+	regionID := flag.String("region", "", "Region to import the image into.")
+	ossBucket := flag.String("oss-bucket", "", "OSS bucket the RHCOS qcow2 was uploaded to.")
+	ossObject := flag.String("oss-object", "", "OSS object key of the uploaded RHCOS qcow2.")
+	imageName := flag.String("image-name", "", "Name to give the imported ECS image.")
+	architecture := flag.String("architecture", "x86_64", "Architecture of the RHCOS qcow2 (x86_64 or arm64).")
+	clusterID := flag.String("cluster-id", "", "Cluster ID to tag the imported image with.")
+	credentialsSecret := flag.String("credentials-secret", "", "Name of the Secret holding Alibaba Cloud credentials. Leave empty to use the ambient RAM role named by ecs-ram-role instead.")
+	credentialsSecretNamespace := flag.String("credentials-secret-namespace", "", "Namespace of the credentials Secret.")
+	ecsRAMRoleName := flag.String("ecs-ram-role", "", "RAM role attached to the ECS instance this command runs on, used via the instance metadata service when credentials-secret is empty.")
+
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	if *regionID == "" || *ossBucket == "" || *ossObject == "" || *imageName == "" || *clusterID == "" {
+		fmt.Fprintln(os.Stderr, "region, oss-bucket, oss-object, image-name and cluster-id are required")
+		os.Exit(1)
+	}
+
+	cfg := config.GetConfigOrDie()
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme); err != nil {
+		klog.Fatalf("Error building scheme: %v", err)
+	}
+	ctrlRuntimeClient, err := runtimeclient.New(cfg, runtimeclient.Options{Scheme: scheme})
+	if err != nil {
+		klog.Fatalf("Error building client: %v", err)
+	}
+
+	alibabacloudClient.SetAmbientRAMRoleName(*ecsRAMRoleName)
+	aliClient, err := alibabacloudClient.NewClient(ctrlRuntimeClient, *credentialsSecret, *credentialsSecretNamespace, *regionID, nil)
+	if err != nil {
+		klog.Fatalf("Error building Alibaba Cloud client: %v", err)
+	}
+
+	imageID, err := images.ImportRHCOSImage(context.Background(), *regionID, *ossBucket, *ossObject, *imageName, *architecture, *clusterID, aliClient)
+	if err != nil {
+		klog.Fatalf("Error importing image: %v", err)
+	}
+
+	fmt.Println(imageID)
+}
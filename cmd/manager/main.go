@@ -18,6 +18,7 @@ package main
 
 import (
 	"flag"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	machineactuator "github.com/openshift/cluster-api-provider-alibaba/pkg/actuators/machine"
 	machinesetcontroller "github.com/openshift/cluster-api-provider-alibaba/pkg/actuators/machineset"
 	"github.com/openshift/cluster-api-provider-alibaba/pkg/apis"
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/webhooks"
 
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/klogr"
@@ -47,6 +49,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
 // The default durations for the leader electrion operations.
@@ -106,6 +109,80 @@ func main() {
 		"Whether to print release version, Default value true.",
 	)
 
+	sdkDefaults := alibabacloudClient.DefaultSDKTransportConfig()
+
+	sdkConnectTimeout := flag.Duration(
+		"alibabacloud-sdk-connect-timeout",
+		sdkDefaults.ConnectTimeout,
+		"Timeout for dialing the Alibaba Cloud API endpoint.",
+	)
+
+	sdkReadTimeout := flag.Duration(
+		"alibabacloud-sdk-read-timeout",
+		sdkDefaults.ReadTimeout,
+		"Timeout for a single Alibaba Cloud API call once the connection is established.",
+	)
+
+	sdkMaxIdleConns := flag.Int(
+		"alibabacloud-sdk-max-idle-conns",
+		sdkDefaults.MaxIdleConns,
+		"Maximum number of idle HTTP connections kept across all Alibaba Cloud API hosts.",
+	)
+
+	sdkMaxIdleConnsPerHost := flag.Int(
+		"alibabacloud-sdk-max-idle-conns-per-host",
+		sdkDefaults.MaxIdleConnsPerHost,
+		"Maximum number of idle HTTP connections kept per Alibaba Cloud API host.",
+	)
+
+	sdkIdleConnTimeout := flag.Duration(
+		"alibabacloud-sdk-idle-conn-timeout",
+		sdkDefaults.IdleConnTimeout,
+		"How long an idle HTTP connection to an Alibaba Cloud API host is kept open before being closed.",
+	)
+
+	sdkCABundle := flag.String(
+		"alibabacloud-sdk-ca-bundle",
+		"",
+		"Path to a PEM-encoded CA bundle trusted for Alibaba Cloud API endpoint TLS verification, in addition to the system roots. Useful when OpenAPI traffic is intercepted by a TLS-inspecting proxy.",
+	)
+
+	ecsEndpoint := flag.String(
+		"alibabacloud-ecs-endpoint",
+		"",
+		"Custom endpoint for the ECS API, overriding the SDK's default public endpoint resolution. Required for clusters that reach Alibaba Cloud over a VPC endpoint or a Gov/Finance cloud domain.",
+	)
+
+	vpcEndpoint := flag.String(
+		"alibabacloud-vpc-endpoint",
+		"",
+		"Custom endpoint for the VPC API, overriding the SDK's default public endpoint resolution. Required for clusters that reach Alibaba Cloud over a VPC endpoint or a Gov/Finance cloud domain.",
+	)
+
+	slbEndpoint := flag.String(
+		"alibabacloud-slb-endpoint",
+		"",
+		"Custom endpoint for the SLB API, overriding the SDK's default public endpoint resolution. Required for clusters that reach Alibaba Cloud over a VPC endpoint or a Gov/Finance cloud domain.",
+	)
+
+	autoResolveRegionalEndpoints := flag.Bool(
+		"alibabacloud-auto-resolve-regional-endpoints",
+		false,
+		"Build each service endpoint directly from the cluster region (e.g. ecs.cn-hangzhou.aliyuncs.com) instead of querying the SDK's location service to discover it. Enable this in Gov/Finance cloud sovereign regions where the location service isn't reachable. Ignored for a service with its endpoint set explicitly.",
+	)
+
+	ecsRAMRoleName := flag.String(
+		"alibabacloud-ecs-ram-role",
+		"",
+		"RAM role attached to the controller's ECS instance to use for machines with no CredentialsSecret, via the instance metadata service. Leave empty to require a CredentialsSecret on every machine.",
+	)
+
+	enableMachineSetWebhook := flag.Bool(
+		"enable-machineset-webhook",
+		false,
+		"Register a validating webhook that warns when a MachineSet's providerSpec targets a region other than the cluster's home region. The ValidatingWebhookConfiguration, TLS certs and Service routing traffic to this process must be provisioned separately.",
+	)
+
 	klog.InitFlags(nil)
 	flag.Set("logtostderr", "true")
 	flag.Parse()
@@ -115,6 +192,36 @@ func main() {
 		klog.Infof("The cluster-api-provider-alibaba version {%s}", version.PrintVerboseVersionInfo())
 	}
 
+	sdkTransportConfig := alibabacloudClient.SDKTransportConfig{
+		ConnectTimeout:      *sdkConnectTimeout,
+		ReadTimeout:         *sdkReadTimeout,
+		MaxIdleConns:        *sdkMaxIdleConns,
+		MaxIdleConnsPerHost: *sdkMaxIdleConnsPerHost,
+		IdleConnTimeout:     *sdkIdleConnTimeout,
+	}
+
+	if *sdkCABundle != "" {
+		caBundle, err := ioutil.ReadFile(*sdkCABundle)
+		if err != nil {
+			klog.Fatalf("failed to read alibabacloud-sdk-ca-bundle %q: %v", *sdkCABundle, err)
+		}
+		sdkTransportConfig.CACertPool, err = alibabacloudClient.LoadCACertPool(caBundle)
+		if err != nil {
+			klog.Fatalf("failed to load alibabacloud-sdk-ca-bundle %q: %v", *sdkCABundle, err)
+		}
+	}
+
+	alibabacloudClient.SetSDKTransportConfig(sdkTransportConfig)
+
+	alibabacloudClient.SetEndpointOverrides(alibabacloudClient.EndpointOverrides{
+		ECS:                 *ecsEndpoint,
+		VPC:                 *vpcEndpoint,
+		SLB:                 *slbEndpoint,
+		AutoResolveRegional: *autoResolveRegionalEndpoints,
+	})
+
+	alibabacloudClient.SetAmbientRAMRoleName(*ecsRAMRoleName)
+
 	cfg := config.GetConfigOrDie()
 	syncPeriod := 10 * time.Minute
 
@@ -186,6 +293,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *enableMachineSetWebhook {
+		mgr.GetWebhookServer().Register(
+			"/validate-alibabacloud-machine-openshift-io-v1beta1-machineset",
+			&webhook.Admission{Handler: &webhooks.MachineSetValidator{Client: mgr.GetClient()}},
+		)
+	}
+
 	if err := mgr.AddReadyzCheck("ping", healthz.Ping); err != nil {
 		klog.Fatal(err)
 	}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command policy prints the minimal RAM policy document covering every Alibaba Cloud API this
+// provider calls, for customers who need to hand their IAM reviewer an accurate, up-to-date policy
+// rather than a broad "AliyunECSFullAccess"-style managed policy.
+package main
+
+import (
+	"encoding/json"
+	"os"
+
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
+)
+
+func main() {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(alibabacloudClient.GenerateMinimalRAMPolicy()); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command replicate-image copies an existing ECS image into another region and waits for the
+// copy to become available, so multi-region machinesets can reference a single logical image
+// without a separate manual import in each region. See pkg/images for the underlying API calls.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/apis"
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/images"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+func main() {
+	sourceRegionID := flag.String("source-region", "", "Region the image currently exists in.")
+	destinationRegionID := flag.String("destination-region", "", "Region to copy the image into.")
+	imageID := flag.String("image-id", "", "ID of the image to copy.")
+	destinationImageName := flag.String("destination-image-name", "", "Name to give the copy in the destination region.")
+	clusterID := flag.String("cluster-id", "", "Cluster ID to tag the copy with.")
+	credentialsSecret := flag.String("credentials-secret", "", "Name of the Secret holding Alibaba Cloud credentials. Leave empty to use the ambient RAM role named by ecs-ram-role instead.")
+	credentialsSecretNamespace := flag.String("credentials-secret-namespace", "", "Namespace of the credentials Secret.")
+	ecsRAMRoleName := flag.String("ecs-ram-role", "", "RAM role attached to the ECS instance this command runs on, used via the instance metadata service when credentials-secret is empty.")
+
+	klog.InitFlags(nil)
+	flag.Parse()
+
+	if *sourceRegionID == "" || *destinationRegionID == "" || *imageID == "" || *destinationImageName == "" || *clusterID == "" {
+		fmt.Fprintln(os.Stderr, "source-region, destination-region, image-id, destination-image-name and cluster-id are required")
+		os.Exit(1)
+	}
+
+	cfg := config.GetConfigOrDie()
+	scheme := runtime.NewScheme()
+	if err := apis.AddToScheme(scheme); err != nil {
+		klog.Fatalf("Error building scheme: %v", err)
+	}
+	ctrlRuntimeClient, err := runtimeclient.New(cfg, runtimeclient.Options{Scheme: scheme})
+	if err != nil {
+		klog.Fatalf("Error building client: %v", err)
+	}
+
+	// CopyImage is issued against the source region, so it needs a client scoped there, but the
+	// availability poll afterwards needs a client scoped to the destination region instead - a
+	// client's per-service endpoint is resolved once at construction and doesn't follow a
+	// request's RegionId, so reusing the source client for the poll would query the wrong region
+	// endpoint whenever one is configured for this process.
+	alibabacloudClient.SetAmbientRAMRoleName(*ecsRAMRoleName)
+	sourceClient, err := alibabacloudClient.NewClient(ctrlRuntimeClient, *credentialsSecret, *credentialsSecretNamespace, *sourceRegionID, nil)
+	if err != nil {
+		klog.Fatalf("Error building Alibaba Cloud client for source region %s: %v", *sourceRegionID, err)
+	}
+	destinationClient, err := alibabacloudClient.NewClient(ctrlRuntimeClient, *credentialsSecret, *credentialsSecretNamespace, *destinationRegionID, nil)
+	if err != nil {
+		klog.Fatalf("Error building Alibaba Cloud client for destination region %s: %v", *destinationRegionID, err)
+	}
+
+	destinationImageID, err := images.CopyImageToRegion(context.Background(), *sourceRegionID, *destinationRegionID, *imageID, *destinationImageName, *clusterID, sourceClient, destinationClient)
+	if err != nil {
+		klog.Fatalf("Error copying image: %v", err)
+	}
+
+	fmt.Println(destinationImageID)
+}
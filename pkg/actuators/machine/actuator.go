@@ -36,6 +36,7 @@ const (
 	noEventAction     = ""
 
 	userDataSecretKey = "userData"
+	passwordSecretKey = "password"
 )
 
 // Actuator is responsible for performing machine reconciliation.
@@ -92,13 +93,14 @@ func (a *Actuator) Create(ctx context.Context, machine *machinev1.Machine) error
 		machine:                   machine,
 		alibabacloudClientBuilder: a.alibabacloudClientBuilder,
 		configManagedClient:       a.configManagedClient,
+		eventRecorder:             a.eventRecorder,
 	})
 
 	if err != nil {
 		return a.handleMachineError(machine, machineapierrors.InvalidMachineConfiguration("failed to create machine %q scope: %v", machine.Name, err), createEventAction)
 	}
 
-	if err = a.reconcilerBuilder(scope).Create(context.Background()); err != nil {
+	if err = a.reconcilerBuilder(scope).Create(ctx); err != nil {
 		if err := scope.patchMachine(); err != nil {
 			return err
 		}
@@ -118,6 +120,7 @@ func (a *Actuator) Update(ctx context.Context, machine *machinev1.Machine) error
 		machine:                   machine,
 		alibabacloudClientBuilder: a.alibabacloudClientBuilder,
 		configManagedClient:       a.configManagedClient,
+		eventRecorder:             a.eventRecorder,
 	})
 
 	if err != nil {
@@ -157,13 +160,14 @@ func (a *Actuator) Delete(ctx context.Context, machine *machinev1.Machine) error
 		machine:                   machine,
 		alibabacloudClientBuilder: a.alibabacloudClientBuilder,
 		configManagedClient:       a.configManagedClient,
+		eventRecorder:             a.eventRecorder,
 	})
 
 	if err != nil {
 		return a.handleMachineError(machine, machineapierrors.DeleteMachine("failed to create machine %q scope: %v", machine.Name, err), deleteEventAction)
 	}
 
-	if err = a.reconcilerBuilder(scope).Delete(context.Background()); err != nil {
+	if err = a.reconcilerBuilder(scope).Delete(ctx); err != nil {
 		if err := scope.patchMachine(); err != nil {
 			return err
 		}
@@ -185,6 +189,7 @@ func (a *Actuator) Exists(ctx context.Context, machine *machinev1.Machine) (bool
 		machine:                   machine,
 		alibabacloudClientBuilder: a.alibabacloudClientBuilder,
 		configManagedClient:       a.configManagedClient,
+		eventRecorder:             a.eventRecorder,
 	})
 
 	if err != nil {
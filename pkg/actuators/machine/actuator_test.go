@@ -104,117 +104,129 @@ func Test_Client(t *testing.T) {
 
 	mockAlibabaCloudClient := mock.NewMockClient(mockCtrl)
 
-	mockAlibabaCloudClient.EXPECT().RunInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RunInstances(stubRunInstancesRequest()).Return(stubRunInstancesResponse(), nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateInstance(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StartInstance(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RebootInstance(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StopInstance(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StartInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RebootInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StopInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteInstance(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AttachInstanceRAMRole(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DetachInstanceRAMRole(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeInstanceStatus(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ReActivateInstances(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeUserData(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeInstanceTypes(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyInstanceAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyInstanceMetadataOptions(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().TagResources(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ListTagResources(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().UntagResources(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().AllocatePublicIPAddress(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().CreateDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AttachDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().DescribeDisks(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyDiskChargeType(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyDiskAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyDiskSpec(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ReplaceSystemDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ReInitDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ResetDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ResizeDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DetachDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteDisk(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().DescribeRegions(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeZones(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().DescribeImages(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AuthorizeSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AuthorizeSecurityGroupEgress(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RevokeSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RevokeSecurityGroupEgress(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().JoinSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().LeaveSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroupAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroups(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroupReferences(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupEgressRule(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupPolicy(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupRule(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().CreateVpc(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteVpc(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeVpcs(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateVSwitch(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteVSwitch(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeVSwitches(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().CreateNatGateway(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeNatGateways(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteNatGateway(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().AllocateEipAddress(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AssociateEipAddress(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyEipAddressAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeEipAddresses(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().UnassociateEipAddress(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ReleaseEipAddress(gomock.Any()).Return(nil, nil).AnyTimes()
-
-	mockAlibabaCloudClient.EXPECT().CreateLoadBalancer(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerTCPListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetLoadBalancerTCPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerTCPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerUDPListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetLoadBalancerUDPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerUDPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerHTTPListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetLoadBalancerHTTPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerHTTPListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerHTTPSListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetLoadBalancerHTTPSListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerHTTPSListenerAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StartLoadBalancerListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().StopLoadBalancerListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteLoadBalancerListener(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerListeners(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AddBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RemoveBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeHealthStatus(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().CreateVServerGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().SetVServerGroupAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().AddVServerGroupBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().RemoveVServerGroupBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().ModifyVServerGroupBackendServers(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DeleteVServerGroup(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeVServerGroups(gomock.Any()).Return(nil, nil).AnyTimes()
-	mockAlibabaCloudClient.EXPECT().DescribeVServerGroupAttribute(gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RunInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RunInstances(gomock.Any(), stubRunInstancesRequest()).Return(stubRunInstancesResponse(), nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StartInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RebootInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StopInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StartInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RebootInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StopInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AttachInstanceRAMRole(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DetachInstanceRAMRole(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeAvailableResource(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeInstanceStatus(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeInstancesFullStatus(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RedeployInstance(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ReActivateInstances(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeUserData(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeInstanceTypes(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyInstanceAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyInstanceMetadataOptions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyInstanceSpec(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().TagResources(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ListTagResources(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().UntagResources(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().AllocatePublicIPAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeNetworkInterfaces(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyNetworkInterfaceAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().CreateDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ApplyAutoSnapshotPolicy(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AttachDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().DescribeDisks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyDiskChargeType(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyDiskAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyDiskSpec(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ReplaceSystemDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ReInitDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ResetDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ResizeDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DetachDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteDisk(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().DescribeRegions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeZones(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ImportImage(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().GetInstanceConsoleOutput(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().GetInstanceScreenshot(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().CreateSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AuthorizeSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AuthorizeSecurityGroupEgress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RevokeSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RevokeSecurityGroupEgress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().JoinSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().LeaveSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroupAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroups(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeSecurityGroupReferences(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupEgressRule(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupPolicy(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifySecurityGroupRule(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteSecurityGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().CreateVpc(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteVpc(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeVpcs(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateVSwitch(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteVSwitch(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeVSwitches(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeVSwitchAttributes(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().CreateNatGateway(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeNatGateways(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteNatGateway(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().AllocateEipAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AssociateEipAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyEipAddressAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeEipAddresses(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().UnassociateEipAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ReleaseEipAddress(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+	mockAlibabaCloudClient.EXPECT().CreateLoadBalancer(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteLoadBalancer(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerTCPListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetLoadBalancerTCPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerTCPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerUDPListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetLoadBalancerUDPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerUDPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerHTTPListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetLoadBalancerHTTPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerHTTPListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateLoadBalancerHTTPSListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetLoadBalancerHTTPSListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerHTTPSListenerAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StartLoadBalancerListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().StopLoadBalancerListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteLoadBalancerListener(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeLoadBalancerListeners(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AddBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RemoveBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeHealthStatus(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().CreateVServerGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().SetVServerGroupAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().AddVServerGroupBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().RemoveVServerGroupBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().ModifyVServerGroupBackendServers(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DeleteVServerGroup(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeVServerGroups(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mockAlibabaCloudClient.EXPECT().DescribeVServerGroupAttribute(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 }
 
 func Test_Machine(t *testing.T) {
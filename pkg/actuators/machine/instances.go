@@ -17,29 +17,44 @@ limitations under the License.
 package machine
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 
-	"k8s.io/klog"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
 
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	clienterrors "github.com/openshift/cluster-api-provider-alibaba/pkg/client/errors"
 	mapierrors "github.com/openshift/machine-api-operator/pkg/controller/machine"
 
+	configv1 "github.com/openshift/api/config/v1"
 	machinev1 "github.com/openshift/api/machine/v1"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
 	"github.com/openshift/machine-api-operator/pkg/metrics"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// infrastructureResourceName is the name of the cluster-scoped Infrastructure object that carries
+// the global resource tags operators set for their cloud provider.
+const infrastructureResourceName = "cluster"
+
 const (
 	// EcsImageStatusAvailable Image status
 	EcsImageStatusAvailable = "Available"
@@ -73,39 +88,182 @@ const (
 
 	// ECSTagResourceTypeInstance  tag resource type
 	ECSTagResourceTypeInstance = "instance"
+	// ECSTagResourceTypeDisk tag resource type
+	ECSTagResourceTypeDisk = "disk"
+
+	// instanceHealthStatusNormal ECS health status reported for an instance with no active
+	// hardware or maintenance events
+	instanceHealthStatusNormal = "Normal"
+
+	// hardwareFailureEventType is the ECS scheduled system event code reported when the
+	// underlying physical host has failed and the instance must be redeployed to recover
+	hardwareFailureEventType = "SystemFailure.Redeploy"
+
+	// InstanceChargeTypePrePaid subscription (PrePaid) instance billing method
+	InstanceChargeTypePrePaid = "PrePaid"
+
+	// architectureX8664 is the image/instance Architecture value for x86_64 instance types
+	architectureX8664 = "x86_64"
+
+	// architectureARM64 is the image/instance Architecture value for Yitian ARM instance types
+	architectureARM64 = "arm64"
+
+	// armInstanceTypeFamilySuffix marks an InstanceTypeFamily as a Yitian ARM family, e.g.
+	// ecs.g8y, ecs.c8y, ecs.r8y
+	armInstanceTypeFamilySuffix = "y"
+
+	// bareMetalInstanceTypeFamilyPrefix marks an InstanceTypeFamily as an ECS Bare Metal family,
+	// e.g. ecs.ebmc5t, ecs.ebmhfg5
+	bareMetalInstanceTypeFamilyPrefix = "ebm"
+
+	// instanceExistsTimeout bounds how long runInstances waits for a newly launched instance to
+	// become describable via DescribeInstances. Waiting for the instance to actually reach
+	// Running is handled by subsequent Update reconciles (see requeueIfInstancePending) instead
+	// of blocking here, so one slow-booting machine cannot starve the reconcile worker.
+	instanceExistsTimeout = 60 * time.Second
+
+	// instanceExistsPollInterval is how often runInstances polls DescribeInstances while waiting
+	// for a newly launched instance to become describable.
+	instanceExistsPollInterval = 5 * time.Second
+
+	// bareMetalUnsupportedSystemDiskCategory is a system disk category ECS Bare Metal instance
+	// types cannot use as a system disk
+	bareMetalUnsupportedSystemDiskCategory = "cloud_efficiency"
+
+	// networkInterfaceTrafficModeHighPerformance enables eRDMA on the primary network interface
+	networkInterfaceTrafficModeHighPerformance = "HighPerformance"
+
+	// windowsOSType is the ecs.Image OSType value reported for Windows images
+	windowsOSType = "windows"
+
+	// windowsMaxHostNameLength is the maximum NetBIOS-compatible host name length accepted by
+	// Windows instances; longer machine names are truncated rather than rejected by RunInstances
+	windowsMaxHostNameLength = 15
+
+	// windowsUserDataScriptTag and windowsUserDataPowershellTag are the tags Alibaba Cloud looks
+	// for to decide how to execute Windows instance user data
+	windowsUserDataScriptTag     = "<script>"
+	windowsUserDataPowershellTag = "<powershell>"
 )
 
+// ramRoleNamePattern matches the name format RAM (Resource Access Management) requires for a
+// role name: 1-64 characters of letters, digits and the characters -._+
+var ramRoleNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.+-]{1,64}$`)
+
+// isBareMetalInstanceType reports whether instanceType belongs to an ECS Bare Metal instance
+// type family (e.g. ecs.ebmc5t.26xlarge), which boots directly on dedicated hardware.
+func isBareMetalInstanceType(instanceType string) bool {
+	parts := strings.SplitN(instanceType, ".", 3)
+	return len(parts) >= 2 && strings.HasPrefix(parts[1], bareMetalInstanceTypeFamilyPrefix)
+}
+
+// formatWindowsUserData wraps userData in a <script> tag, Alibaba Cloud's convention for running
+// a batch script on a Windows instance, unless the caller already supplied an explicit <script>
+// or <powershell> tag.
+func formatWindowsUserData(userData string) string {
+	trimmed := strings.TrimSpace(userData)
+	lower := strings.ToLower(trimmed)
+	if strings.HasPrefix(lower, windowsUserDataScriptTag) || strings.HasPrefix(lower, windowsUserDataPowershellTag) {
+		return userData
+	}
+
+	return fmt.Sprintf("%s\n%s\n</script>", windowsUserDataScriptTag, userData)
+}
+
+// formatHostName applies hostNameFormat to machineName: a "%s" verb in hostNameFormat is
+// replaced with machineName, otherwise hostNameFormat is appended to machineName as a suffix
+// (e.g. a domain). An empty hostNameFormat leaves machineName unchanged.
+func formatHostName(machineName, hostNameFormat string) string {
+	if hostNameFormat == "" {
+		return machineName
+	}
+
+	if strings.Contains(hostNameFormat, "%s") {
+		return fmt.Sprintf(hostNameFormat, machineName)
+	}
+
+	return machineName + hostNameFormat
+}
+
 // runInstances create ecs
-func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, userData string, client alibabacloudClient.Client) (*ecs.Instance, error) {
+func runInstances(ctx context.Context, k8sClient runtimeclient.Client, machine *machinev1beta1.Machine, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, userData string, password string, client alibabacloudClient.Client) (*ecs.Instance, error) {
 	machineKey := runtimeclient.ObjectKey{
 		Name:      machine.Name,
 		Namespace: machine.Namespace,
 	}
 
+	clusterID, ok := getClusterID(machine)
+	if !ok {
+		klog.Errorf("Unable to get cluster ID for machine: %q", machine.Name)
+		return nil, mapierrors.InvalidMachineConfiguration("Unable to get cluster ID for machine: %q", machine.Name)
+	}
+
+	if machineProviderConfig.InstanceID != "" {
+		return adoptInstance(ctx, machine.Name, clusterID, machineProviderConfig.InstanceID, machineProviderConfig.RegionID, client)
+	}
+
 	// ImageID
-	imageID, err := getImageID(machineKey, machineProviderConfig, client)
+	imageID, imageArchitecture, imageOSType, err := getImageID(ctx, machineKey, machineProviderConfig, client)
 	if err != nil {
 		return nil, mapierrors.InvalidMachineConfiguration("error getting ImageID: %v", err)
 	}
+	isWindows := strings.EqualFold(imageOSType, windowsOSType)
+
+	if err := validateImageArchitecture(ctx, machineProviderConfig, imageArchitecture, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating image architecture: %v", err)
+	}
 
 	// SecurgityGroupIds
-	securityGroupIDs, err := getSecurityGroupIDs(machineKey, machineProviderConfig, client)
+	securityGroupIDs, err := getSecurityGroupIDs(ctx, machineKey, clusterID, machineProviderConfig, client)
 	if err != nil {
 		return nil, mapierrors.InvalidMachineConfiguration("error getting security groups ID: %v", err)
 	}
 
+	if err := validateSecurityGroups(ctx, *securityGroupIDs, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating security groups: %v", err)
+	}
+
 	// VSwitchID
-	vSwitchID, err := getVSwitchID(machineKey, machineProviderConfig, client)
+	vSwitchID, err := getVSwitchID(ctx, machineKey, clusterID, machineProviderConfig, client)
 	if err != nil {
 		return nil, mapierrors.InvalidMachineConfiguration("error getting vswitch ID: %v", err)
 	}
 
-	clusterID, ok := getClusterID(machine)
-	if !ok {
-		klog.Errorf("Unable to get cluster ID for machine: %q", machine.Name)
-		return nil, mapierrors.InvalidMachineConfiguration("Unable to get cluster ID for machine: %q", machine.Name)
+	if err := validateVSwitch(ctx, vSwitchID, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating vswitch: %v", err)
+	}
+
+	if err := validatePrivateIPAddress(ctx, machineProviderConfig.PrivateIPAddress, vSwitchID, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating private IP address: %v", err)
 	}
 
+	if err := validateInstanceAvailability(ctx, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating instance availability: %v", err)
+	}
+
+	if isBareMetalInstanceType(machineProviderConfig.InstanceType) && machineProviderConfig.SystemDisk.Category == bareMetalUnsupportedSystemDiskCategory {
+		return nil, mapierrors.InvalidMachineConfiguration("system disk category %s is not supported by bare metal instance type %s",
+			machineProviderConfig.SystemDisk.Category, machineProviderConfig.InstanceType)
+	}
+
+	if err := validateRAMRoleName(machineProviderConfig.RAMRoleName); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating RAM role name: %v", err)
+	}
+
+	if err := validateERDMASupport(ctx, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating eRDMA support: %v", err)
+	}
+
+	if err := validateNetworkInterfaceQueueNumber(ctx, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating network interface queue number: %v", err)
+	}
+
+	if err := validateDiskCategoryAvailability(ctx, machineProviderConfig, client); err != nil {
+		return nil, mapierrors.InvalidMachineConfiguration("error validating disk category availability: %v", err)
+	}
+
+	warnIfSerialConsoleEnabledUnsupported(machineProviderConfig)
+
 	// RunInstanceRequest init request params
 	runInstancesRequest := ecs.CreateRunInstancesRequest()
 	// Scheme, set to https
@@ -114,6 +272,19 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 	// RegionID
 	runInstancesRequest.RegionId = machineProviderConfig.RegionID
 
+	// LaunchTemplate (base configuration to merge machine-api managed fields over)
+	if machineProviderConfig.LaunchTemplate != nil {
+		if machineProviderConfig.LaunchTemplate.ID != "" {
+			runInstancesRequest.LaunchTemplateId = machineProviderConfig.LaunchTemplate.ID
+		} else if machineProviderConfig.LaunchTemplate.Name != "" {
+			runInstancesRequest.LaunchTemplateName = machineProviderConfig.LaunchTemplate.Name
+		}
+
+		if machineProviderConfig.LaunchTemplate.Version > 0 {
+			runInstancesRequest.LaunchTemplateVersion = requests.NewInteger64(machineProviderConfig.LaunchTemplate.Version)
+		}
+	}
+
 	// ResourceGroupID
 	if machineProviderConfig.ResourceGroupID != "" {
 		runInstancesRequest.ResourceGroupId = machineProviderConfig.ResourceGroupID
@@ -123,7 +294,11 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 	runInstancesRequest.SecurityGroupIds = securityGroupIDs
 
 	// Add tags to the created machine
-	tagList := buildTagList(machine.Name, clusterID, machineProviderConfig.Tags)
+	infrastructureTags, err := getInfrastructureResourceTags(ctx, k8sClient)
+	if err != nil {
+		klog.Errorf("%s: failed to read infrastructure resource tags: %v", machine.Name, err)
+	}
+	tagList := buildTagList(machine.Name, clusterID, machineProviderConfig.Tags, infrastructureTags)
 
 	// Tags
 	runInstancesRequest.Tag = covertToRunInstancesTag(tagList)
@@ -137,8 +312,63 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 	// InstanceName
 	runInstancesRequest.InstanceName = machine.GetName()
 
-	// HostName
-	runInstancesRequest.HostName = machine.GetName()
+	// HostName. Windows instances require a NetBIOS-compatible host name, so truncate rather
+	// than let a long machine name fail RunInstances outright.
+	hostName := formatHostName(machine.GetName(), machineProviderConfig.HostNameFormat)
+	if isWindows && len(hostName) > windowsMaxHostNameLength {
+		hostName = hostName[:windowsMaxHostNameLength]
+	}
+	runInstancesRequest.HostName = hostName
+
+	// KeyPairName
+	if machineProviderConfig.KeyPairName != "" {
+		runInstancesRequest.KeyPairName = machineProviderConfig.KeyPairName
+	}
+
+	// SecurityEnhancementStrategy
+	if machineProviderConfig.SecurityEnhancementStrategy != "" {
+		runInstancesRequest.SecurityEnhancementStrategy = machineProviderConfig.SecurityEnhancementStrategy
+	}
+
+	// HpcClusterId
+	if machineProviderConfig.HpcClusterID != "" {
+		runInstancesRequest.HpcClusterId = machineProviderConfig.HpcClusterID
+	}
+
+	// NetworkInterfaceTrafficMode has no typed field on the vendored RunInstancesRequest yet;
+	// set it as a raw query parameter on the primary (first) network interface instead.
+	if machineProviderConfig.NetworkInterfaceTrafficMode != "" {
+		runInstancesRequest.QueryParams["NetworkInterface.1.NetworkInterfaceTrafficMode"] = machineProviderConfig.NetworkInterfaceTrafficMode
+	}
+
+	// SecondaryPrivateIPCount has no typed field on the vendored RunInstancesRequest yet; set it
+	// as a raw query parameter on the primary (first) network interface instead.
+	if machineProviderConfig.SecondaryPrivateIPCount > 0 {
+		runInstancesRequest.QueryParams["NetworkInterface.1.SecondaryPrivateIpAddressCount"] = strconv.FormatInt(machineProviderConfig.SecondaryPrivateIPCount, 10)
+	}
+
+	// NetworkInterfaceQueueNumber
+	if machineProviderConfig.NetworkInterfaceQueueNumber > 0 {
+		runInstancesRequest.NetworkInterfaceQueueNumber = requests.NewInteger64(machineProviderConfig.NetworkInterfaceQueueNumber)
+	}
+
+	// TrustedSystemMode enables a vTPM for measured/trusted boot. Confidential computing
+	// (Inclavare/TDX) families are not modeled separately: the vendored SDK does not expose a
+	// distinct request field or instance type attribute for them, so enabling confidential
+	// computing support would require vendoring a newer SDK version.
+	if machineProviderConfig.TrustedSystemMode != "" {
+		runInstancesRequest.SecurityOptionsTrustedSystemMode = machineProviderConfig.TrustedSystemMode
+	}
+
+	// Password
+	if password != "" {
+		runInstancesRequest.Password = password
+	}
+
+	// DeletionProtection
+	if machineProviderConfig.DeletionProtection {
+		runInstancesRequest.DeletionProtection = requests.NewBoolean(true)
+	}
 
 	// Amount
 	runInstancesRequest.Amount = requests.NewInteger(1)
@@ -161,9 +391,35 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 		runInstancesRequest.InternetMaxBandwidthIn = requests.NewInteger64(machineProviderConfig.Bandwidth.InternetMaxBandwidthIn)
 	}
 
+	// InternetChargeType
+	if machineProviderConfig.Bandwidth.InternetChargeType != "" {
+		runInstancesRequest.InternetChargeType = machineProviderConfig.Bandwidth.InternetChargeType
+	}
+
 	// VswitchId
 	runInstancesRequest.VSwitchId = vSwitchID
 
+	// PrivateIpAddress
+	if machineProviderConfig.PrivateIPAddress != "" {
+		runInstancesRequest.PrivateIpAddress = machineProviderConfig.PrivateIPAddress
+	}
+
+	// MetadataOptions
+	if machineProviderConfig.MetadataOptions.HTTPTokens != "" {
+		runInstancesRequest.HttpTokens = machineProviderConfig.MetadataOptions.HTTPTokens
+	}
+	if machineProviderConfig.MetadataOptions.HTTPEndpoint != "" {
+		runInstancesRequest.HttpEndpoint = machineProviderConfig.MetadataOptions.HTTPEndpoint
+	}
+	if machineProviderConfig.MetadataOptions.HTTPPutResponseHopLimit > 0 {
+		runInstancesRequest.HttpPutResponseHopLimit = requests.NewInteger64(machineProviderConfig.MetadataOptions.HTTPPutResponseHopLimit)
+	}
+	if machineProviderConfig.MetadataOptions.InstanceMetadataTags != "" {
+		// InstanceMetadataTags has no typed field on the vendored RunInstancesRequest yet; set it
+		// as a raw query parameter instead.
+		runInstancesRequest.QueryParams["InstanceMetadataTags"] = machineProviderConfig.MetadataOptions.InstanceMetadataTags
+	}
+
 	// SystemDisk
 	runInstancesRequest.SystemDiskCategory = machineProviderConfig.SystemDisk.Category
 	runInstancesRequest.SystemDiskSize = strconv.FormatInt(machineProviderConfig.SystemDisk.Size, 10)
@@ -175,10 +431,23 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 		runInstancesRequest.SystemDiskPerformanceLevel = machineProviderConfig.SystemDisk.PerformanceLevel
 	}
 
+	if machineProviderConfig.StorageSetID != "" {
+		runInstancesRequest.StorageSetId = machineProviderConfig.StorageSetID
+		if machineProviderConfig.StorageSetPartitionNumber > 0 {
+			runInstancesRequest.StorageSetPartitionNumber = requests.NewInteger64(machineProviderConfig.StorageSetPartitionNumber)
+		}
+	}
+
 	// DataDisk
 	if len(machineProviderConfig.DataDisks) > 0 {
 		dataDisks := make([]ecs.RunInstancesDataDisk, 0)
 		for _, dataDisk := range machineProviderConfig.DataDisks {
+			// DiskID entries reference an existing disk and are attached separately via
+			// AttachDisk once the instance is Running; see attachExistingDataDisks.
+			if dataDisk.DiskID != "" {
+				continue
+			}
+
 			runInstancesDataDisk := ecs.RunInstancesDataDisk{
 				Size:      strconv.FormatInt(dataDisk.Size, 10),
 				Category:  string(dataDisk.Category),
@@ -214,7 +483,68 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 		runInstancesRequest.DataDisk = &dataDisks
 	}
 
+	// NetworkInterfaces (secondary ENIs, e.g. for multus/Terway)
+	if len(machineProviderConfig.NetworkInterfaces) > 0 {
+		networkInterfaces := make([]ecs.RunInstancesNetworkInterface, 0, len(machineProviderConfig.NetworkInterfaces))
+		for _, eni := range machineProviderConfig.NetworkInterfaces {
+			runInstancesNetworkInterface := ecs.RunInstancesNetworkInterface{
+				VSwitchId: eni.VSwitch.ID,
+			}
+
+			if eni.PrimaryIPAddress != "" {
+				runInstancesNetworkInterface.PrimaryIpAddress = eni.PrimaryIPAddress
+			}
+
+			if eni.QueueNumber > 0 {
+				runInstancesNetworkInterface.QueueNumber = strconv.FormatInt(eni.QueueNumber, 10)
+			}
+
+			if len(eni.SecurityGroups) > 0 {
+				eniSecurityGroupIDs := make([]string, 0, len(eni.SecurityGroups))
+				for _, sg := range eni.SecurityGroups {
+					if sg.ID != "" {
+						eniSecurityGroupIDs = append(eniSecurityGroupIDs, sg.ID)
+					}
+				}
+				runInstancesNetworkInterface.SecurityGroupIds = &eniSecurityGroupIDs
+			}
+
+			networkInterfaces = append(networkInterfaces, runInstancesNetworkInterface)
+		}
+		runInstancesRequest.NetworkInterface = &networkInterfaces
+	}
+
+	// Ipv6AddressCount / Ipv6Addresses for dual-stack clusters
+	if len(machineProviderConfig.Ipv6Addresses) > 0 {
+		ipv6Addresses := machineProviderConfig.Ipv6Addresses
+		runInstancesRequest.Ipv6Address = &ipv6Addresses
+	} else if machineProviderConfig.Ipv6AddressCount > 0 {
+		runInstancesRequest.Ipv6AddressCount = requests.NewInteger64(machineProviderConfig.Ipv6AddressCount)
+	}
+
+	// CpuOptions (core count / threads per core, e.g. to disable hyper-threading)
+	if machineProviderConfig.CpuOptions.Core > 0 || machineProviderConfig.CpuOptions.ThreadsPerCore > 0 {
+		if err := validateCPUOptions(ctx, machineProviderConfig, client); err != nil {
+			return nil, mapierrors.InvalidMachineConfiguration("error validating CPU options: %v", err)
+		}
+
+		if machineProviderConfig.CpuOptions.Core > 0 {
+			runInstancesRequest.CpuOptionsCore = requests.NewInteger64(machineProviderConfig.CpuOptions.Core)
+		}
+
+		if machineProviderConfig.CpuOptions.ThreadsPerCore > 0 {
+			runInstancesRequest.CpuOptionsThreadsPerCore = requests.NewInteger64(machineProviderConfig.CpuOptions.ThreadsPerCore)
+		}
+	}
+
 	if userData != "" {
+		if isWindows {
+			decoded, err := base64.StdEncoding.DecodeString(userData)
+			if err != nil {
+				return nil, mapierrors.InvalidMachineConfiguration("error decoding user data: %v", err)
+			}
+			userData = base64.StdEncoding.EncodeToString([]byte(formatWindowsUserData(string(decoded))))
+		}
 		runInstancesRequest.UserData = userData
 	}
 
@@ -233,7 +563,21 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 			machinev1.DefaultTenancy,
 			machinev1.HostTenancy)
 	}
-	runResponse, err := client.RunInstances(runInstancesRequest)
+	// Subscription (PrePaid billing)
+	if machineProviderConfig.Subscription != nil {
+		runInstancesRequest.InstanceChargeType = InstanceChargeTypePrePaid
+		runInstancesRequest.Period = requests.NewInteger64(machineProviderConfig.Subscription.Period)
+
+		if machineProviderConfig.Subscription.PeriodUnit != "" {
+			runInstancesRequest.PeriodUnit = machineProviderConfig.Subscription.PeriodUnit
+		}
+
+		if machineProviderConfig.Subscription.AutoRenew {
+			runInstancesRequest.AutoRenew = requests.NewBoolean(true)
+		}
+	}
+
+	runResponse, err := client.RunInstances(ctx, runInstancesRequest)
 	if err != nil {
 		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
 			Name:      machine.Name,
@@ -242,7 +586,7 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 		})
 
 		klog.Errorf("Error creating ECS instance: %v", err)
-		return nil, mapierrors.CreateMachine("error creating ECS instance: %v", err)
+		return nil, classifyRunInstancesError(err)
 	}
 
 	if runResponse == nil || len(runResponse.InstanceIdSets.InstanceIdSet) != 1 {
@@ -250,11 +594,11 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 		return nil, mapierrors.CreateMachine("unexpected reservation creating instance")
 	}
 
-	// Sleep
-	time.Sleep(5 * time.Second)
-
-	// Query the status of the instance until Running
-	instance, err := waitForInstancesStatus(client, machineProviderConfig.RegionID, []string{runResponse.InstanceIdSets.InstanceIdSet[0]}, ECSInstanceStatusRunning, InstanceDefaultTimeout)
+	// Wait only for the instance to become describable, not for it to reach Running. Instance
+	// boot time (substantially longer for Bare Metal, which boots directly on dedicated
+	// hardware) is absorbed by subsequent Update reconciles via requeueIfInstancePending,
+	// instead of blocking the reconcile worker here for the full boot duration.
+	instance, err := waitForInstanceExists(ctx, client, machineProviderConfig.RegionID, runResponse.InstanceIdSets.InstanceIdSet[0], instanceExistsTimeout)
 	if err != nil {
 		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
 			Name:      machine.Name,
@@ -262,77 +606,665 @@ func runInstances(machine *machinev1beta1.Machine, machineProviderConfig *machin
 			Reason:    err.Error(),
 		})
 
-		klog.Errorf("Error waiting ECS instance to Running: %v", err)
-		return nil, mapierrors.CreateMachine("error waiting ECS instance to Running: %v", err)
+		klog.Errorf("Error waiting for ECS instance to become describable: %v", err)
+		bootDiagnostics := fetchBootDiagnostics(ctx, runResponse.InstanceIdSets.InstanceIdSet[0], client)
+		return nil, mapierrors.CreateMachine("error waiting for ECS instance to become describable: %v; %s", err, bootDiagnostics)
 	}
 
-	if instance == nil || len(instance) < 1 {
+	if instance == nil {
 		return nil, mapierrors.CreateMachine(" ECS instance %s not found", runResponse.InstanceIdSets.InstanceIdSet[0])
 	}
 
-	return instance[0], nil
+	if err := attachExistingDataDisks(ctx, machineProviderConfig, instance.InstanceId, client); err != nil {
+		return nil, mapierrors.CreateMachine("error attaching existing data disks: %v", err)
+	}
+
+	if err := applyAutoSnapshotPolicy(ctx, machineProviderConfig.AutoSnapshotPolicyID, instance.InstanceId, client); err != nil {
+		return nil, mapierrors.CreateMachine("error applying auto snapshot policy: %v", err)
+	}
+
+	if err := tagInstanceDisks(ctx, machine.Name, clusterID, instance.InstanceId, client); err != nil {
+		return nil, mapierrors.CreateMachine("error tagging instance disks: %v", err)
+	}
+
+	if err := preserveSystemDisk(ctx, machineProviderConfig, instance.InstanceId, client); err != nil {
+		return nil, mapierrors.CreateMachine("error preserving system disk: %v", err)
+	}
+
+	return instance, nil
 }
 
-// waitForInstancesStatus waits for instances to given status when instance.NotFound wait until timeout
-func waitForInstancesStatus(client alibabacloudClient.Client, regionID string, instanceIds []string, instanceStatus string, timeout int) ([]*ecs.Instance, error) {
+// adoptInstance adopts a pre-existing ECS instance referenced by InstanceID instead of launching
+// a new one, enabling BYO-node and disaster-recovery workflows where the instance was created out
+// of band. It verifies the instance exists and corrects its cluster/machine tags (so the tag-based
+// lookups in getInstances/getMachineInstances find it on subsequent reconciles the same way they
+// find instances this actuator created itself), but otherwise leaves the instance untouched.
+func adoptInstance(ctx context.Context, machineName string, clusterID string, instanceID string, regionID string, client alibabacloudClient.Client) (*ecs.Instance, error) {
+	describeInstancesRequest := ecs.CreateDescribeInstancesRequest()
+	describeInstancesRequest.Scheme = "https"
+	describeInstancesRequest.RegionId = regionID
+	ids, _ := json.Marshal([]string{instanceID})
+	describeInstancesRequest.InstanceIds = string(ids)
+	describeInstancesResponse, err := client.DescribeInstances(ctx, describeInstancesRequest)
+	if err != nil {
+		return nil, mapierrors.CreateMachine("error describing instance %s to adopt: %v", instanceID, err)
+	}
+
+	if describeInstancesResponse == nil || len(describeInstancesResponse.Instances.Instance) != 1 {
+		return nil, mapierrors.InvalidMachineConfiguration("instance %s to adopt was not found in region %s", instanceID, regionID)
+	}
+
+	instance := describeInstancesResponse.Instances.Instance[0]
+
+	tagResourcesRequest := ecs.CreateTagResourcesRequest()
+	tagResourcesRequest.Scheme = "https"
+	tagResourcesRequest.RegionId = regionID
+	tagResourcesRequest.ResourceId = &[]string{instanceID}
+	tagResourcesRequest.ResourceType = ECSTagResourceTypeInstance
+	tagResourcesRequest.Tag = tagResourceTags(clusterID, machineName)
+	if _, err := client.TagResources(ctx, tagResourcesRequest); err != nil {
+		return nil, mapierrors.CreateMachine("error tagging adopted instance %s: %v", instanceID, err)
+	}
+
+	return &instance, nil
+}
+
+// waitForInstanceExists waits for instanceID to become visible via DescribeInstances,
+// regardless of its status, and returns it as soon as it does. Unlike waitForInstancesStatus,
+// it does not wait for any particular status: newly launched instances can take a long time to
+// reach Running, and that wait is left to subsequent reconciles instead of blocking here.
+//
+// Polling is driven by wait.PollImmediateUntilWithContext rather than a fixed sleep, so it
+// returns as soon as ctx is cancelled (e.g. the controller is shutting down) instead of always
+// running to timeout.
+func waitForInstanceExists(ctx context.Context, client alibabacloudClient.Client, regionID string, instanceID string, timeout time.Duration) (*ecs.Instance, error) {
 	if timeout <= 0 {
-		timeout = InstanceDefaultTimeout
+		timeout = instanceExistsTimeout
 	}
 
-	result, err := WaitForResult(fmt.Sprintf("Wait for the instances %v state to change to %s ", instanceIds, instanceStatus), func() (stop bool, result interface{}, err error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var instance *ecs.Instance
+	err := wait.PollImmediateUntilWithContext(ctx, instanceExistsPollInterval, func(ctx context.Context) (bool, error) {
 		describeInstancesRequest := ecs.CreateDescribeInstancesRequest()
 		describeInstancesRequest.RegionId = regionID
-		ids, _ := json.Marshal(instanceIds)
+		ids, _ := json.Marshal([]string{instanceID})
 		describeInstancesRequest.InstanceIds = string(ids)
 		describeInstancesRequest.Scheme = "https"
-		describeInstancesResponse, err := client.DescribeInstances(describeInstancesRequest)
-		klog.V(3).Infof("instance resonpse %v", describeInstancesResponse)
+		describeInstancesResponse, err := client.DescribeInstances(ctx, describeInstancesRequest)
+		if err != nil {
+			klog.Errorf("wait for instance %s to become describable: %v", instanceID, err)
+			return false, nil
+		}
+
+		if len(describeInstancesResponse.Instances.Instance) < 1 {
+			return false, nil
+		}
+
+		instance = &describeInstancesResponse.Instances.Instance[0]
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("wait for instance %s to become describable: %w", instanceID, err)
+	}
+
+	return instance, nil
+}
+
+// preserveSystemDisk overrides the system disk's delete-with-instance behavior to false when
+// SystemDisk.DeleteWithInstance is explicitly set to false, so the root disk survives machine
+// deletion for forensics. RunInstances has no typed parameter to set this at creation time for
+// the system disk (unlike data disks), so it is applied afterwards via ModifyDiskAttribute.
+func preserveSystemDisk(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, instanceID string, client alibabacloudClient.Client) error {
+	if machineProviderConfig.SystemDisk.DeleteWithInstance == nil || *machineProviderConfig.SystemDisk.DeleteWithInstance {
+		return nil
+	}
+
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instanceID
+	describeDisksRequest.DiskType = "system"
+	describeDisksResponse, err := client.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("error describing system disk for instance %s: %v", instanceID, err)
+	}
+	if describeDisksResponse == nil || len(describeDisksResponse.Disks.Disk) == 0 {
+		return nil
+	}
+
+	modifyDiskAttributeRequest := ecs.CreateModifyDiskAttributeRequest()
+	modifyDiskAttributeRequest.Scheme = "https"
+	modifyDiskAttributeRequest.DiskId = describeDisksResponse.Disks.Disk[0].DiskId
+	modifyDiskAttributeRequest.DeleteWithInstance = requests.NewBoolean(false)
+	if _, err := client.ModifyDiskAttribute(ctx, modifyDiskAttributeRequest); err != nil {
+		return fmt.Errorf("error setting DeleteWithInstance=false on system disk %s for instance %s: %v", modifyDiskAttributeRequest.DiskId, instanceID, err)
+	}
+
+	return nil
+}
+
+// tagInstanceDisks tags every disk (system and data) attached to instanceID with the same
+// cluster-owned and Name tags carried by the instance itself, so cost allocation tooling and the
+// orphaned-volume GC controller can find disks belonging to a machine the same way they find the
+// instance.
+func tagInstanceDisks(ctx context.Context, machineName string, clusterID string, instanceID string, client alibabacloudClient.Client) error {
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instanceID
+	describeDisksResponse, err := client.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("error describing disks for instance %s: %v", instanceID, err)
+	}
+	if describeDisksResponse == nil || len(describeDisksResponse.Disks.Disk) == 0 {
+		return nil
+	}
+
+	diskIDs := make([]string, 0, len(describeDisksResponse.Disks.Disk))
+	for _, disk := range describeDisksResponse.Disks.Disk {
+		diskIDs = append(diskIDs, disk.DiskId)
+	}
+
+	tagResourcesRequest := ecs.CreateTagResourcesRequest()
+	tagResourcesRequest.Scheme = "https"
+	tagResourcesRequest.ResourceId = &diskIDs
+	tagResourcesRequest.ResourceType = ECSTagResourceTypeDisk
+	tagResourcesRequest.Tag = tagResourceTags(clusterID, machineName)
+	if _, err := client.TagResources(ctx, tagResourcesRequest); err != nil {
+		return fmt.Errorf("error tagging disks %v for instance %s: %v", diskIDs, instanceID, err)
+	}
+
+	return nil
+}
+
+// attachExistingDataDisks attaches every DataDisks entry that references an existing disk by
+// DiskID to instanceID, once the instance is Running.
+func attachExistingDataDisks(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, instanceID string, client alibabacloudClient.Client) error {
+	for _, dataDisk := range machineProviderConfig.DataDisks {
+		if dataDisk.DiskID == "" {
+			continue
+		}
+
+		request := ecs.CreateAttachDiskRequest()
+		request.Scheme = "https"
+		request.InstanceId = instanceID
+		request.DiskId = dataDisk.DiskID
+
+		if _, err := client.AttachDisk(ctx, request); err != nil {
+			return fmt.Errorf("error attaching disk %s to instance %s: %v", dataDisk.DiskID, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+// detachExistingDataDisks detaches every DataDisks entry that references an existing disk by
+// DiskID from instanceID, without deleting the disk, so it can be reused by a future machine.
+func detachExistingDataDisks(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, instanceID string, client alibabacloudClient.Client) error {
+	for _, dataDisk := range machineProviderConfig.DataDisks {
+		if dataDisk.DiskID == "" {
+			continue
+		}
+
+		request := ecs.CreateDetachDiskRequest()
+		request.Scheme = "https"
+		request.InstanceId = instanceID
+		request.DiskId = dataDisk.DiskID
+
+		if _, err := client.DetachDisk(ctx, request); err != nil {
+			return fmt.Errorf("error detaching disk %s from instance %s: %v", dataDisk.DiskID, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+// applyAutoSnapshotPolicy applies autoSnapshotPolicyID, if set, to every disk (system and data)
+// attached to instanceID, so the machine's disks get scheduled backups.
+func applyAutoSnapshotPolicy(ctx context.Context, autoSnapshotPolicyID string, instanceID string, client alibabacloudClient.Client) error {
+	if autoSnapshotPolicyID == "" {
+		return nil
+	}
+
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instanceID
+
+	describeDisksResponse, err := client.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("error describing disks for instance %s: %v", instanceID, err)
+	}
+
+	if describeDisksResponse == nil || len(describeDisksResponse.Disks.Disk) == 0 {
+		return nil
+	}
+
+	diskIDs := make([]string, 0, len(describeDisksResponse.Disks.Disk))
+	for _, disk := range describeDisksResponse.Disks.Disk {
+		diskIDs = append(diskIDs, disk.DiskId)
+	}
+
+	diskIDsJSON, err := json.Marshal(diskIDs)
+	if err != nil {
+		return fmt.Errorf("error marshaling disk IDs for instance %s: %v", instanceID, err)
+	}
+
+	applyAutoSnapshotPolicyRequest := ecs.CreateApplyAutoSnapshotPolicyRequest()
+	applyAutoSnapshotPolicyRequest.Scheme = "https"
+	applyAutoSnapshotPolicyRequest.AutoSnapshotPolicyId = autoSnapshotPolicyID
+	applyAutoSnapshotPolicyRequest.DiskIds = string(diskIDsJSON)
+
+	if _, err := client.ApplyAutoSnapshotPolicy(ctx, applyAutoSnapshotPolicyRequest); err != nil {
+		return fmt.Errorf("error applying auto snapshot policy %s to instance %s disks: %v", autoSnapshotPolicyID, instanceID, err)
+	}
+
+	return nil
+}
+
+// waitForInstancesStatus waits for instances to given status when instance.NotFound wait until timeout
+func waitForInstancesStatus(ctx context.Context, client alibabacloudClient.Client, regionID string, instanceIds []string, instanceStatus string, timeout int) ([]*ecs.Instance, error) {
+	if timeout <= 0 {
+		timeout = InstanceDefaultTimeout
+	}
+
+	// Poll with the lightweight DescribeInstanceStatus API, which only returns InstanceId and
+	// Status, rather than DescribeInstances' full instance records, to keep this loop's API
+	// weight (and throttling risk) low while it runs every DefaultWaitForInterval seconds.
+	_, err := WaitForResult(fmt.Sprintf("Wait for the instances %v state to change to %s ", instanceIds, instanceStatus), func() (stop bool, result interface{}, err error) {
+		describeInstanceStatusRequest := ecs.CreateDescribeInstanceStatusRequest()
+		describeInstanceStatusRequest.RegionId = regionID
+		describeInstanceStatusRequest.InstanceId = &instanceIds
+		describeInstanceStatusRequest.Scheme = "https"
+		describeInstanceStatusResponse, err := client.DescribeInstanceStatus(ctx, describeInstanceStatusRequest)
+		klog.V(3).Infof("instance status resonpse %v", describeInstanceStatusResponse)
 		if err != nil {
 			return false, nil, err
 		}
 
-		if len(describeInstancesResponse.Instances.Instance) <= 0 {
-			return true, nil, fmt.Errorf("the instances %v not found. ", instanceIds)
-		}
+		if len(describeInstanceStatusResponse.InstanceStatuses.InstanceStatus) <= 0 {
+			return true, nil, fmt.Errorf("the instances %v not found. ", instanceIds)
+		}
+
+		idsLen := len(instanceIds)
+		matched := 0
+
+		for _, instanceStatusEntry := range describeInstanceStatusResponse.InstanceStatuses.InstanceStatus {
+			if instanceStatusEntry.Status == instanceStatus {
+				matched++
+			}
+		}
+
+		if matched == idsLen {
+			return true, nil, nil
+		}
+
+		return false, nil, fmt.Errorf("the instances  %v state are not  the expected state  %s ", instanceIds, instanceStatus)
+
+	}, false, DefaultWaitForInterval, timeout)
+
+	if err != nil {
+		klog.Errorf("Wait for the instances %v state change to %v occur error %v", instanceIds, instanceStatus, err)
+		return nil, err
+	}
+
+	// Now that every instance has reached instanceStatus, fetch the full records once.
+	describeInstancesRequest := ecs.CreateDescribeInstancesRequest()
+	describeInstancesRequest.RegionId = regionID
+	ids, _ := json.Marshal(instanceIds)
+	describeInstancesRequest.InstanceIds = string(ids)
+	describeInstancesRequest.Scheme = "https"
+	describeInstancesResponse, err := client.DescribeInstances(ctx, describeInstancesRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	if describeInstancesResponse == nil || len(describeInstancesResponse.Instances.Instance) <= 0 {
+		return nil, nil
+	}
+
+	instances := make([]*ecs.Instance, 0, len(describeInstancesResponse.Instances.Instance))
+	for _, instance := range describeInstancesResponse.Instances.Instance {
+		instances = append(instances, &instance)
+	}
+
+	return instances, nil
+}
+
+// waitForInstancesDeleted waits for every instance in instanceIds to disappear from
+// DescribeInstanceStatus, confirming a Force DeleteInstances call has fully terminated them
+// rather than merely requesting their termination.
+func waitForInstancesDeleted(ctx context.Context, client alibabacloudClient.Client, regionID string, instanceIds []string, timeout int) error {
+	if timeout <= 0 {
+		timeout = InstanceDefaultTimeout
+	}
+
+	_, err := WaitForResult(fmt.Sprintf("wait for the instances %v to be deleted", instanceIds), func() (stop bool, result interface{}, err error) {
+		describeInstanceStatusRequest := ecs.CreateDescribeInstanceStatusRequest()
+		describeInstanceStatusRequest.RegionId = regionID
+		describeInstanceStatusRequest.InstanceId = &instanceIds
+		describeInstanceStatusRequest.Scheme = "https"
+		describeInstanceStatusResponse, err := client.DescribeInstanceStatus(ctx, describeInstanceStatusRequest)
+		if err != nil {
+			return false, nil, err
+		}
+
+		if len(describeInstanceStatusResponse.InstanceStatuses.InstanceStatus) == 0 {
+			return true, nil, nil
+		}
+
+		return false, nil, fmt.Errorf("the instances %v still exist", instanceIds)
+	}, false, DefaultWaitForInterval, timeout)
+
+	return err
+}
+
+// bootDiagnosticsConsoleOutputMaxLength truncates the console output embedded in a boot-failure
+// error message, since the full output can be many kilobytes and only the most recent lines are
+// useful for debugging why an instance never became describable after RunInstances.
+const bootDiagnosticsConsoleOutputMaxLength = 2000
+
+// fetchBootDiagnostics best-effort fetches the serial console output and a screenshot of the
+// instance's VNC display, returning a short human-readable summary to attach to the boot-failure
+// error so debugging doesn't require a separate manual console lookup. Errors fetching the
+// diagnostics themselves are folded into the summary rather than returned, since they must not
+// mask the original waitForInstanceExists error.
+func fetchBootDiagnostics(ctx context.Context, instanceID string, client alibabacloudClient.Client) string {
+	consoleOutputRequest := ecs.CreateGetInstanceConsoleOutputRequest()
+	consoleOutputRequest.Scheme = "https"
+	consoleOutputRequest.InstanceId = instanceID
+	consoleOutput := "console output unavailable"
+	if response, err := client.GetInstanceConsoleOutput(ctx, consoleOutputRequest); err != nil {
+		consoleOutput = fmt.Sprintf("console output unavailable: %v", err)
+	} else if response != nil && response.ConsoleOutput != "" {
+		output := response.ConsoleOutput
+		if len(output) > bootDiagnosticsConsoleOutputMaxLength {
+			output = output[len(output)-bootDiagnosticsConsoleOutputMaxLength:]
+		}
+		consoleOutput = fmt.Sprintf("console output (last %d chars): %s", len(output), output)
+	}
+
+	screenshotRequest := ecs.CreateGetInstanceScreenshotRequest()
+	screenshotRequest.Scheme = "https"
+	screenshotRequest.InstanceId = instanceID
+	screenshot := "screenshot unavailable"
+	if response, err := client.GetInstanceScreenshot(ctx, screenshotRequest); err != nil {
+		screenshot = fmt.Sprintf("screenshot unavailable: %v", err)
+	} else if response != nil && response.Screenshot != "" {
+		screenshot = fmt.Sprintf("screenshot captured (%d bytes, base64 PNG)", len(response.Screenshot))
+	}
+
+	return fmt.Sprintf("boot diagnostics for instance %s: %s; %s", instanceID, consoleOutput, screenshot)
+}
+
+// validateCPUOptions checks that the requested CPU core count and threads-per-core divide evenly
+// into the vCPU count of the chosen instance type.
+func validateCPUOptions(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, client, machineProviderConfig.RegionID, machineProviderConfig.InstanceType)
+	if err != nil {
+		return fmt.Errorf("error describing instance type %s: %v", machineProviderConfig.InstanceType, err)
+	}
+
+	vCPUCount := int64(it.CpuCoreCount)
+
+	threadsPerCore := machineProviderConfig.CpuOptions.ThreadsPerCore
+	if threadsPerCore == 0 {
+		threadsPerCore = 1
+	}
+
+	core := machineProviderConfig.CpuOptions.Core
+	if core == 0 {
+		core = vCPUCount / threadsPerCore
+	}
+
+	if core*threadsPerCore != vCPUCount {
+		return fmt.Errorf("cpuOptions.core (%d) * cpuOptions.threadsPerCore (%d) must equal the %d vCPUs provided by instance type %s",
+			core, threadsPerCore, vCPUCount, machineProviderConfig.InstanceType)
+	}
+
+	return nil
+}
+
+// classifyRunInstancesError maps known Alibaba Cloud error codes to the right machine-api
+// outcome: a transient stock-out requeues for a later retry, while a quota or unsupported
+// instance type error is terminal and surfaces as a machine error so the machineset can mark
+// the machine Failed instead of retrying forever.
+func classifyRunInstancesError(err error) error {
+	var serverError *sdkerrors.ServerError
+	if !errors.As(err, &serverError) {
+		return mapierrors.CreateMachine("error creating ECS instance: %v", err)
+	}
+
+	switch clienterrors.ClassifyCode(serverError.ErrorCode()) {
+	case clienterrors.CategoryStock:
+		// RequeueAfterError.Error() only renders the requeue delay, so log the original error here
+		// or its RequestId would never appear anywhere once it's wrapped into the typed error below.
+		klog.Warningf("instance type out of stock, will retry: %v", err)
+		return &mapierrors.RequeueAfterError{RequeueAfter: requeueAfterFatalSeconds * time.Second}
+	case clienterrors.CategoryQuota:
+		return mapierrors.InvalidMachineConfiguration("quota exceeded creating ECS instance: %v", err)
+	case clienterrors.CategoryInvalid:
+		return mapierrors.InvalidMachineConfiguration("instance type not supported: %v", err)
+	default:
+		return mapierrors.CreateMachine("error creating ECS instance: %v", err)
+	}
+}
+
+// requestIDSuffix returns " (RequestId: ...)" when err is (or wraps) an Alibaba Cloud
+// sdkerrors.ServerError, for appending to event messages and logs so API-side failures are
+// traceable from `oc describe machine` without needing to reproduce them.
+func requestIDSuffix(err error) string {
+	var serverError *sdkerrors.ServerError
+	if !errors.As(err, &serverError) || serverError.RequestId() == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (RequestId: %s)", serverError.RequestId())
+}
+
+// validateInstanceAvailability performs a preflight DescribeAvailableResource check to confirm
+// the requested instance type and system disk category are purchasable in the target zone,
+// converting Alibaba Cloud stock-outs into a clear configuration error instead of a failed
+// RunInstances call.
+func validateInstanceAvailability(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	request := ecs.CreateDescribeAvailableResourceRequest()
+	request.Scheme = "https"
+	request.RegionId = machineProviderConfig.RegionID
+	request.ZoneId = machineProviderConfig.ZoneID
+	request.InstanceType = machineProviderConfig.InstanceType
+	request.DestinationResource = "InstanceType"
+	request.SystemDiskCategory = machineProviderConfig.SystemDisk.Category
+
+	response, err := client.DescribeAvailableResource(ctx, request)
+	if err != nil {
+		return fmt.Errorf("error describing available resource: %v", err)
+	}
+
+	if response == nil || len(response.AvailableZones.AvailableZone) == 0 {
+		// Nothing to validate against; do not block instance creation on an empty response.
+		return nil
+	}
+
+	for _, zone := range response.AvailableZones.AvailableZone {
+		if zone.Status != "Available" {
+			continue
+		}
+		for _, availableResource := range zone.AvailableResources.AvailableResource {
+			for _, supportedResource := range availableResource.SupportedResources.SupportedResource {
+				if supportedResource.Status == "Available" {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("instance type %s with system disk category %s is not purchasable in zone %s",
+		machineProviderConfig.InstanceType, machineProviderConfig.SystemDisk.Category, machineProviderConfig.ZoneID)
+}
+
+// validateDiskCategoryAvailability checks that the system disk category, and every data disk
+// category, is actually purchasable in the target zone before RunInstances is called, so a
+// category that has been retired or never rolled out in that zone fails with a clear
+// configuration error instead of an opaque RunInstances failure.
+func validateDiskCategoryAvailability(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	if err := validateDiskCategoryAvailabilityForResource(ctx, "SystemDisk", string(machineProviderConfig.SystemDisk.Category), machineProviderConfig, client); err != nil {
+		return err
+	}
+
+	for _, dataDisk := range machineProviderConfig.DataDisks {
+		if dataDisk.DiskID != "" {
+			// References an existing disk; no new disk of this category is being purchased.
+			continue
+		}
+		if err := validateDiskCategoryAvailabilityForResource(ctx, "DataDisk", string(dataDisk.Category), machineProviderConfig, client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateDiskCategoryAvailabilityForResource checks that diskCategory is purchasable for
+// destinationResource ("SystemDisk" or "DataDisk") in the target zone.
+func validateDiskCategoryAvailabilityForResource(ctx context.Context, destinationResource string, diskCategory string, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	if diskCategory == "" {
+		return nil
+	}
+
+	request := ecs.CreateDescribeAvailableResourceRequest()
+	request.Scheme = "https"
+	request.RegionId = machineProviderConfig.RegionID
+	request.ZoneId = machineProviderConfig.ZoneID
+	request.DestinationResource = destinationResource
+	if destinationResource == "DataDisk" {
+		request.DataDiskCategory = diskCategory
+	} else {
+		request.SystemDiskCategory = diskCategory
+	}
+
+	response, err := client.DescribeAvailableResource(ctx, request)
+	if err != nil {
+		return fmt.Errorf("error describing available resource: %v", err)
+	}
+
+	if response == nil || len(response.AvailableZones.AvailableZone) == 0 {
+		// Nothing to validate against; do not block instance creation on an empty response.
+		return nil
+	}
+
+	for _, zone := range response.AvailableZones.AvailableZone {
+		if zone.Status != "Available" {
+			continue
+		}
+		for _, availableResource := range zone.AvailableResources.AvailableResource {
+			for _, supportedResource := range availableResource.SupportedResources.SupportedResource {
+				if supportedResource.Value == diskCategory && supportedResource.Status == "Available" {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("%s category %s is not available in zone %s", destinationResource, diskCategory, machineProviderConfig.ZoneID)
+}
+
+// validateRAMRoleName checks that RAMRoleName, if set, is a syntactically valid RAM role name.
+// Ideally this would also confirm the role exists via the RAM ListRoles/GetRole APIs so a typo
+// surfaces as a clear configuration error instead of failing deep inside RunInstances, but the
+// RAM service is not among the Alibaba Cloud SDK clients vendored in this tree, so only the
+// format check below is performed here.
+func validateRAMRoleName(ramRoleName string) error {
+	if ramRoleName == "" {
+		return nil
+	}
+
+	if !ramRoleNamePattern.MatchString(ramRoleName) {
+		return fmt.Errorf("RAM role name %q is not a valid RAM role name", ramRoleName)
+	}
+
+	return nil
+}
 
-		idsLen := len(instanceIds)
-		instances := make([]*ecs.Instance, 0)
+// warnIfSerialConsoleEnabledUnsupported logs that SerialConsoleEnabled has no effect.
+//
+// Unlike AWS or GCP, Alibaba Cloud does not gate serial console access behind an
+// instance-creation or ModifyInstanceAttribute flag: GetInstanceConsoleOutput (used by
+// fetchBootDiagnostics) is available for any instance with no enablement step, and neither
+// RunInstancesRequest nor ModifyInstanceAttributeRequest in this vendored SDK version exposes
+// such a parameter to set even if one existed. The field is accepted for forward compatibility
+// with a future API addition, but does not change instance creation today.
+func warnIfSerialConsoleEnabledUnsupported(machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig) {
+	if machineProviderConfig.SerialConsoleEnabled == nil || !*machineProviderConfig.SerialConsoleEnabled {
+		return
+	}
 
-		for _, instance := range describeInstancesResponse.Instances.Instance {
-			if instance.Status == instanceStatus {
-				instances = append(instances, &instance)
-			}
-		}
+	klog.Warningf("SerialConsoleEnabled is set but has no effect: Alibaba Cloud instance console output is available without an explicit enablement step")
+}
 
-		if len(instances) == idsLen {
-			return true, instances, nil
-		}
+// validateERDMASupport confirms the instance type has at least one Elastic RDMA Interface (ERI)
+// before allowing NetworkInterfaceTrafficMode to be set to HighPerformance, so a typo'd or
+// incompatible instance type fails with a clear configuration error instead of at RunInstances.
+func validateERDMASupport(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	if machineProviderConfig.NetworkInterfaceTrafficMode != networkInterfaceTrafficModeHighPerformance {
+		return nil
+	}
 
-		return false, nil, fmt.Errorf("the instances  %v state are not  the expected state  %s ", instanceIds, instanceStatus)
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, client, machineProviderConfig.RegionID, machineProviderConfig.InstanceType)
+	if err != nil {
+		return fmt.Errorf("error describing instance type %s: %v", machineProviderConfig.InstanceType, err)
+	}
 
-	}, false, DefaultWaitForInterval, timeout)
+	if it.EriQuantity <= 0 {
+		return fmt.Errorf("instance type %s does not support eRDMA", machineProviderConfig.InstanceType)
+	}
+
+	return nil
+}
+
+// validateNetworkInterfaceQueueNumber checks NetworkInterfaceQueueNumber, if set, against the
+// instance type's maximum queue count for its primary ENI.
+func validateNetworkInterfaceQueueNumber(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	if machineProviderConfig.NetworkInterfaceQueueNumber <= 0 {
+		return nil
+	}
 
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, client, machineProviderConfig.RegionID, machineProviderConfig.InstanceType)
 	if err != nil {
-		klog.Errorf("Wait for the instances %v state change to %v occur error %v", instanceIds, instanceStatus, err)
-		return nil, err
+		return fmt.Errorf("error describing instance type %s: %v", machineProviderConfig.InstanceType, err)
 	}
 
-	if result == nil {
-		return nil, nil
+	if it.PrimaryEniQueueNumber > 0 && machineProviderConfig.NetworkInterfaceQueueNumber > int64(it.PrimaryEniQueueNumber) {
+		return fmt.Errorf("instance type %s supports a maximum of %d queues on its primary network interface, requested %d",
+			machineProviderConfig.InstanceType, it.PrimaryEniQueueNumber, machineProviderConfig.NetworkInterfaceQueueNumber)
 	}
 
-	return result.([]*ecs.Instance), nil
+	return nil
 }
 
-func getImageID(machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, error) {
+// getImageID resolves the image to use for the instance, along with its architecture and OS
+// type for use by validateImageArchitecture and Windows-specific handling.
+//
+// Note: validating that the image's declared boot mode (BIOS/UEFI) matches what the instance
+// type supports is not implemented, because the vendored ecs.Image and ecs.InstanceType structs
+// in this SDK version do not expose a boot mode field to check against.
+func getImageID(ctx context.Context, machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, string, string, error) {
 	klog.Infof("%s validate image in region %s", machineProviderConfig.ImageID, machineProviderConfig.RegionID)
 	request := ecs.CreateDescribeImagesRequest()
-	request.ImageId = machineProviderConfig.ImageID
 	request.RegionId = machineProviderConfig.RegionID
 	request.ShowExpired = requests.NewBoolean(true)
 	request.Scheme = "https"
 
-	response, err := client.DescribeImages(request)
+	if machineProviderConfig.ImageID != "" {
+		request.ImageId = machineProviderConfig.ImageID
+	} else if machineProviderConfig.ImageFilter != nil {
+		request.ImageName = machineProviderConfig.ImageFilter.Name
+		request.ImageFamily = machineProviderConfig.ImageFilter.Family
+		request.ImageOwnerAlias = machineProviderConfig.ImageFilter.OwnerAlias
+		request.Architecture = machineProviderConfig.ImageFilter.Architecture
+	} else {
+		return "", "", "", fmt.Errorf("one of imageId or imageFilter must be set")
+	}
+
+	response, err := client.DescribeImages(ctx, request)
 	if err != nil {
 		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
 			Name:      machine.Name,
@@ -340,43 +1272,87 @@ func getImageID(machine runtimeclient.ObjectKey, machineProviderConfig *machinev
 			Reason:    err.Error(),
 		})
 		klog.Errorf("error describing Image: %v", err)
-		return "", fmt.Errorf("error describing Images: %v", err)
+		return "", "", "", fmt.Errorf("error describing Images: %v", err)
 	}
 
 	if len(response.Images.Image) < 1 {
 		klog.Errorf("no image for given filters not found")
-		return "", fmt.Errorf("no image for given filters not found")
+		return "", "", "", fmt.Errorf("no image for given filters not found")
 	}
 
-	image := response.Images.Image[0]
+	images := response.Images.Image
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].CreationTime > images[j].CreationTime
+	})
+
+	image := images[0]
 	if image.Status != EcsImageStatusAvailable {
-		klog.Errorf("%s invalid image status: %s", machineProviderConfig.ImageID, image.Status)
-		return "", fmt.Errorf("%s invalid image status: %s", machineProviderConfig.ImageID, image.Status)
+		klog.Errorf("%s invalid image status: %s", image.ImageId, image.Status)
+		return "", "", "", fmt.Errorf("%s invalid image status: %s", image.ImageId, image.Status)
+	}
+
+	return image.ImageId, image.Architecture, image.OSType, nil
+}
+
+// validateImageArchitecture confirms the resolved image's CPU architecture matches the
+// architecture of the requested instance type (Yitian ARM families such as g8y/c8y require an
+// arm64 image), returning a clear configuration error instead of an instance that fails to boot.
+func validateImageArchitecture(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, imageArchitecture string, client alibabacloudClient.Client) error {
+	if imageArchitecture == "" {
+		return nil
+	}
+
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, client, machineProviderConfig.RegionID, machineProviderConfig.InstanceType)
+	if err != nil {
+		return fmt.Errorf("error describing instance type %s: %v", machineProviderConfig.InstanceType, err)
+	}
+
+	instanceArchitecture := architectureX8664
+	if strings.HasSuffix(it.InstanceTypeFamily, armInstanceTypeFamilySuffix) {
+		instanceArchitecture = architectureARM64
+	}
+
+	if imageArchitecture != instanceArchitecture {
+		return fmt.Errorf("image architecture %s does not match instance type %s architecture %s",
+			imageArchitecture, machineProviderConfig.InstanceType, instanceArchitecture)
 	}
 
-	return image.ImageId, nil
+	return nil
 }
 
-func getSecurityGroupIDs(machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (*[]string, error) {
+func getSecurityGroupIDs(ctx context.Context, machine runtimeclient.ObjectKey, clusterID string, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (*[]string, error) {
 	klog.Infof("query security groups in region %s", machineProviderConfig.RegionID)
 	var securityGroupIDs []string
 
 	// If SecurityGroupID is assigned, use it directly
 	if len(machineProviderConfig.SecurityGroups) == 0 {
-		return nil, errors.New("no security configuration provided")
+		if !machineProviderConfig.ManagedSecurityGroup {
+			return nil, errors.New("no security configuration provided")
+		}
+
+		sgID, err := getOrCreateManagedSecurityGroup(ctx, machine, clusterID, machineProviderConfig, client)
+		if err != nil {
+			return nil, err
+		}
+		return &[]string{sgID}, nil
 	}
 
 	for _, sg := range machineProviderConfig.SecurityGroups {
-		if sg.ID != "" {
+		switch {
+		case sg.ID != "":
 			securityGroupIDs = append(securityGroupIDs, sg.ID)
-		} else {
-			if sg.Tags != nil {
-				ids, err := getSecurityGroupIDByTags(machine, machineProviderConfig, sg.Tags, client)
-				if err != nil {
-					return nil, err
-				}
-				securityGroupIDs = append(securityGroupIDs, ids...)
+		case sg.Name != "":
+			id, err := getSecurityGroupIDByName(ctx, machine, machineProviderConfig, sg.Name, client)
+			if err != nil {
+				return nil, err
 			}
+			securityGroupIDs = append(securityGroupIDs, id)
+		case sg.Tags != nil:
+			ids, err := getSecurityGroupIDByTags(ctx, machine, machineProviderConfig, sg.Tags, client)
+			if err != nil {
+				return nil, err
+			}
+			securityGroupIDs = append(securityGroupIDs, ids...)
 		}
 	}
 	if len(securityGroupIDs) == 0 {
@@ -385,7 +1361,39 @@ func getSecurityGroupIDs(machine runtimeclient.ObjectKey, machineProviderConfig
 	return &securityGroupIDs, nil
 }
 
-func getSecurityGroupIDByTags(machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, tags []machinev1.Tag, client alibabacloudClient.Client) ([]string, error) {
+func getSecurityGroupIDByName(ctx context.Context, machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, name string, client alibabacloudClient.Client) (string, error) {
+	request := ecs.CreateDescribeSecurityGroupsRequest()
+	request.VpcId = machineProviderConfig.VpcID
+	request.ResourceGroupId = machineProviderConfig.ResourceGroupID
+	request.RegionId = machineProviderConfig.RegionID
+	request.SecurityGroupName = name
+	request.Scheme = "https"
+
+	response, err := client.DescribeSecurityGroups(ctx, request)
+	if err != nil {
+		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
+			Name:      machine.Name,
+			Namespace: machine.Namespace,
+			Reason:    err.Error(),
+		})
+		klog.Errorf("error describing securitygroup: %v", err)
+		return "", fmt.Errorf("error describing securitygroup: %v", err)
+	}
+
+	if len(response.SecurityGroups.SecurityGroup) < 1 {
+		klog.Errorf("no securitygroup named %q found in vpc %q", name, machineProviderConfig.VpcID)
+		return "", fmt.Errorf("no securitygroup named %q found in vpc %q", name, machineProviderConfig.VpcID)
+	}
+
+	if len(response.SecurityGroups.SecurityGroup) > 1 {
+		klog.Errorf("more than one securitygroup named %q found in vpc %q", name, machineProviderConfig.VpcID)
+		return "", fmt.Errorf("more than one securitygroup named %q found in vpc %q", name, machineProviderConfig.VpcID)
+	}
+
+	return response.SecurityGroups.SecurityGroup[0].SecurityGroupId, nil
+}
+
+func getSecurityGroupIDByTags(ctx context.Context, machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, tags []machinev1.Tag, client alibabacloudClient.Client) ([]string, error) {
 	request := ecs.CreateDescribeSecurityGroupsRequest()
 	request.VpcId = machineProviderConfig.VpcID
 	request.ResourceGroupId = machineProviderConfig.ResourceGroupID
@@ -393,7 +1401,7 @@ func getSecurityGroupIDByTags(machine runtimeclient.ObjectKey, machineProviderCo
 	request.Tag = buildDescribeSecurityGroupsTag(tags)
 	request.Scheme = "https"
 
-	response, err := client.DescribeSecurityGroups(request)
+	response, err := client.DescribeSecurityGroups(ctx, request)
 	if err != nil {
 		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
 			Name:      machine.Name,
@@ -409,11 +1417,77 @@ func getSecurityGroupIDByTags(machine runtimeclient.ObjectKey, machineProviderCo
 	}
 	securityGroupIDs := []string{}
 	for _, sg := range response.SecurityGroups.SecurityGroup {
+		if sg.EcsCount >= getMaxInstancesBySecurityGroupType(sg.SecurityGroupType) {
+			klog.Infof("security group %s is at its %s capacity limit (%d members), skipping", sg.SecurityGroupId, sg.SecurityGroupType, sg.EcsCount)
+			continue
+		}
 		securityGroupIDs = append(securityGroupIDs, sg.SecurityGroupId)
 	}
+	if len(securityGroupIDs) == 0 {
+		klog.Errorf("all securitygroups for given tags are at capacity")
+		return nil, fmt.Errorf("all securitygroups for given tags are at capacity")
+	}
 	return securityGroupIDs, nil
 }
 
+// getOrCreateManagedSecurityGroup finds the cluster-owned security group, creating it with
+// default rules on first use. The group is tagged with kubernetes.io/cluster/<clusterID> so it
+// can be found again by later machines and garbage collected with the cluster.
+func getOrCreateManagedSecurityGroup(ctx context.Context, machine runtimeclient.ObjectKey, clusterID string, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, error) {
+	tags := []machinev1.Tag{{Key: clusterFilterKeyPrefix + clusterID, Value: clusterFilterValue}}
+
+	ids, err := getSecurityGroupIDByTags(ctx, machine, machineProviderConfig, tags, client)
+	if err == nil && len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	klog.Infof("no managed security group found for cluster %s, creating one", clusterID)
+
+	createRequest := ecs.CreateCreateSecurityGroupRequest()
+	createRequest.RegionId = machineProviderConfig.RegionID
+	createRequest.VpcId = machineProviderConfig.VpcID
+	createRequest.SecurityGroupName = fmt.Sprintf("%s-managed", clusterID)
+	createRequest.Description = fmt.Sprintf("Managed security group for cluster %s, created by cluster-api-provider-alibaba", clusterID)
+	createRequest.Tag = &[]ecs.CreateSecurityGroupTag{{Key: clusterFilterKeyPrefix + clusterID, Value: clusterFilterValue}}
+	createRequest.Scheme = "https"
+
+	createResponse, err := client.CreateSecurityGroup(ctx, createRequest)
+	if err != nil {
+		return "", fmt.Errorf("error creating managed security group: %v", err)
+	}
+
+	if err := authorizeManagedSecurityGroupDefaults(ctx, createResponse.SecurityGroupId, client); err != nil {
+		return "", fmt.Errorf("error authorizing rules on managed security group %s: %v", createResponse.SecurityGroupId, err)
+	}
+
+	return createResponse.SecurityGroupId, nil
+}
+
+// authorizeManagedSecurityGroupDefaults opens the minimum ingress rules a cluster node needs:
+// all traffic from within the security group itself, plus SSH.
+func authorizeManagedSecurityGroupDefaults(ctx context.Context, securityGroupID string, client alibabacloudClient.Client) error {
+	intraGroupRule := ecs.CreateAuthorizeSecurityGroupRequest()
+	intraGroupRule.SecurityGroupId = securityGroupID
+	intraGroupRule.IpProtocol = "ALL"
+	intraGroupRule.PortRange = "-1/-1"
+	intraGroupRule.SourceGroupId = securityGroupID
+	intraGroupRule.Scheme = "https"
+
+	if _, err := client.AuthorizeSecurityGroup(ctx, intraGroupRule); err != nil {
+		return err
+	}
+
+	sshRule := ecs.CreateAuthorizeSecurityGroupRequest()
+	sshRule.SecurityGroupId = securityGroupID
+	sshRule.IpProtocol = "tcp"
+	sshRule.PortRange = "22/22"
+	sshRule.SourceCidrIp = "0.0.0.0/0"
+	sshRule.Scheme = "https"
+
+	_, err := client.AuthorizeSecurityGroup(ctx, sshRule)
+	return err
+}
+
 func getMaxInstancesBySecurityGroupType(securityGroupType string) int {
 	switch securityGroupType {
 	case SecurityGroupTypeNormal:
@@ -438,31 +1512,37 @@ func buildDescribeSecurityGroupsTag(tags []machinev1.Tag) *[]ecs.DescribeSecurit
 	return &describeSecurityGroupsTag
 }
 
-func getVSwitchID(machine runtimeclient.ObjectKey, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, error) {
+func getVSwitchID(ctx context.Context, machine runtimeclient.ObjectKey, clusterID string, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, error) {
 	klog.Infof("validate vswitch in region %s", machineProviderConfig.RegionID)
-	if machineProviderConfig.VSwitch.ID == "" && len(machineProviderConfig.VSwitch.Tags) == 0 {
-		return "", errors.New("no vswitch configuration provided")
-	}
+	vSwitch := machineProviderConfig.VSwitch
+	if vSwitch.ID == "" && vSwitch.Name == "" && len(vSwitch.Tags) == 0 {
+		if machineProviderConfig.ZoneID == "" {
+			return "", errors.New("no vswitch configuration provided")
+		}
 
-	if machineProviderConfig.VSwitch.ID != "" {
-		return machineProviderConfig.VSwitch.ID, nil
+		// Only a zone was given: discover the cluster-tagged vswitch in that zone.
+		klog.Infof("no vswitch configured, discovering cluster-tagged vswitch in zone %s", machineProviderConfig.ZoneID)
+		tags := []machinev1.Tag{{Key: clusterFilterKeyPrefix + clusterID, Value: clusterFilterValue}}
+		return getVSwitchIDFromFilters(ctx, machine, machineProviderConfig, tags, client)
 	}
 
-	if machineProviderConfig.VSwitch.Tags != nil {
-		return getVSwitchIDFromTags(machine, machineProviderConfig, client)
+	if vSwitch.ID != "" {
+		return vSwitch.ID, nil
 	}
 
-	return "", fmt.Errorf("no vSwitch found from configuration")
+	return getVSwitchIDFromFilters(ctx, machine, machineProviderConfig, vSwitch.Tags, client)
 }
 
-func getVSwitchIDFromTags(machine runtimeclient.ObjectKey, mpc *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) (string, error) {
-	// Build a request to fetch the vSwitchID from the tags provided
+func getVSwitchIDFromFilters(ctx context.Context, machine runtimeclient.ObjectKey, mpc *machinev1.AlibabaCloudMachineProviderConfig, tags []machinev1.Tag, client alibabacloudClient.Client) (string, error) {
+	// Build a request to fetch the vSwitchID from the name, tags and/or zone provided
 	describeVSwitchesRequest := vpc.CreateDescribeVSwitchesRequest()
 	describeVSwitchesRequest.Scheme = "https"
 	describeVSwitchesRequest.RegionId = mpc.RegionID
 	describeVSwitchesRequest.VpcId = mpc.VpcID
-	describeVSwitchesRequest.Tag = buildDescribeVSwitchesTag(mpc.VSwitch.Tags)
-	describeVSwitchesResponse, err := client.DescribeVSwitches(describeVSwitchesRequest)
+	describeVSwitchesRequest.VSwitchName = mpc.VSwitch.Name
+	describeVSwitchesRequest.ZoneId = mpc.ZoneID
+	describeVSwitchesRequest.Tag = buildDescribeVSwitchesTag(tags)
+	describeVSwitchesResponse, err := client.DescribeVSwitches(ctx, describeVSwitchesRequest)
 	if err != nil {
 		metrics.RegisterFailedInstanceCreate(&metrics.MachineLabels{
 			Name:      machine.Name,
@@ -473,12 +1553,125 @@ func getVSwitchIDFromTags(machine runtimeclient.ObjectKey, mpc *machinev1.Alibab
 		return "", fmt.Errorf("error describing vswitches: %v", err)
 	}
 	if len(describeVSwitchesResponse.VSwitches.VSwitch) < 1 {
-		klog.Errorf("no vswitches found for given tags, vpcid, and regionid")
-		return "", fmt.Errorf("no vswitches found for given tags, vpcid, and regionid")
+		klog.Errorf("no vswitches found for given name, tags, vpcid, zoneid and regionid")
+		return "", fmt.Errorf("no vswitches found for given name, tags, vpcid, zoneid and regionid")
+	}
+	if len(describeVSwitchesResponse.VSwitches.VSwitch) > 1 && mpc.VSwitch.Name != "" {
+		klog.Errorf("more than one vswitch named %q found", mpc.VSwitch.Name)
+		return "", fmt.Errorf("more than one vswitch named %q found", mpc.VSwitch.Name)
 	}
 	return describeVSwitchesResponse.VSwitches.VSwitch[0].VSwitchId, nil
 }
 
+// validateVSwitch confirms the resolved vSwitch belongs to the configured VPC and, if set, zone,
+// so a misconfiguration is reported as an InvalidMachineConfiguration rather than surfacing as a
+// late RunInstances failure.
+func validateVSwitch(ctx context.Context, vSwitchID string, mpc *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	request := vpc.CreateDescribeVSwitchAttributesRequest()
+	request.Scheme = "https"
+	request.VSwitchId = vSwitchID
+
+	response, err := client.DescribeVSwitchAttributes(ctx, request)
+	if err != nil {
+		return fmt.Errorf("error describing vswitch %s: %v", vSwitchID, err)
+	}
+
+	if mpc.VpcID != "" && response.VpcId != mpc.VpcID {
+		return fmt.Errorf("vswitch %s belongs to vpc %s, expected %s", vSwitchID, response.VpcId, mpc.VpcID)
+	}
+
+	if mpc.ZoneID != "" && response.ZoneId != mpc.ZoneID {
+		return fmt.Errorf("vswitch %s belongs to zone %s, expected %s", vSwitchID, response.ZoneId, mpc.ZoneID)
+	}
+
+	if mpc.ResourceGroupID != "" && response.ResourceGroupId != mpc.ResourceGroupID {
+		return fmt.Errorf("vswitch %s belongs to resource group %s, expected %s", vSwitchID, response.ResourceGroupId, mpc.ResourceGroupID)
+	}
+
+	return nil
+}
+
+// validateSecurityGroups confirms every resolved security group belongs to the configured
+// resource group, so a security group reused by ID across resource group boundaries is reported
+// as an InvalidMachineConfiguration instead of silently mixing resources across groups. It's a
+// no-op when ResourceGroupID isn't set, since enforcement only makes sense once there's a
+// resource group to enforce.
+func validateSecurityGroups(ctx context.Context, securityGroupIDs []string, mpc *machinev1.AlibabaCloudMachineProviderConfig, client alibabacloudClient.Client) error {
+	if mpc.ResourceGroupID == "" {
+		return nil
+	}
+
+	for _, securityGroupID := range securityGroupIDs {
+		request := ecs.CreateDescribeSecurityGroupsRequest()
+		request.Scheme = "https"
+		request.SecurityGroupId = securityGroupID
+
+		response, err := client.DescribeSecurityGroups(ctx, request)
+		if err != nil {
+			return fmt.Errorf("error describing security group %s: %v", securityGroupID, err)
+		}
+
+		if len(response.SecurityGroups.SecurityGroup) < 1 {
+			return fmt.Errorf("security group %s not found", securityGroupID)
+		}
+
+		if resourceGroupID := response.SecurityGroups.SecurityGroup[0].ResourceGroupId; resourceGroupID != mpc.ResourceGroupID {
+			return fmt.Errorf("security group %s belongs to resource group %s, expected %s", securityGroupID, resourceGroupID, mpc.ResourceGroupID)
+		}
+	}
+
+	return nil
+}
+
+// validatePrivateIPAddress confirms that privateIPAddress, if set, falls inside vSwitchID's CIDR
+// block and is not already assigned to a network interface in the vswitch.
+func validatePrivateIPAddress(ctx context.Context, privateIPAddress string, vSwitchID string, client alibabacloudClient.Client) error {
+	if privateIPAddress == "" {
+		return nil
+	}
+
+	ip := net.ParseIP(privateIPAddress)
+	if ip == nil {
+		return fmt.Errorf("invalid private IP address: %s", privateIPAddress)
+	}
+
+	describeVSwitchAttributesRequest := vpc.CreateDescribeVSwitchAttributesRequest()
+	describeVSwitchAttributesRequest.Scheme = "https"
+	describeVSwitchAttributesRequest.VSwitchId = vSwitchID
+
+	vSwitchAttributes, err := client.DescribeVSwitchAttributes(ctx, describeVSwitchAttributesRequest)
+	if err != nil {
+		return fmt.Errorf("error describing vswitch %s: %v", vSwitchID, err)
+	}
+
+	if vSwitchAttributes != nil && vSwitchAttributes.CidrBlock != "" {
+		_, cidr, err := net.ParseCIDR(vSwitchAttributes.CidrBlock)
+		if err != nil {
+			return fmt.Errorf("error parsing CIDR block %s of vswitch %s: %v", vSwitchAttributes.CidrBlock, vSwitchID, err)
+		}
+
+		if !cidr.Contains(ip) {
+			return fmt.Errorf("private IP address %s is not within vswitch %s CIDR block %s", privateIPAddress, vSwitchID, vSwitchAttributes.CidrBlock)
+		}
+	}
+
+	describeNetworkInterfacesRequest := ecs.CreateDescribeNetworkInterfacesRequest()
+	describeNetworkInterfacesRequest.Scheme = "https"
+	describeNetworkInterfacesRequest.VSwitchId = vSwitchID
+	describeNetworkInterfacesRequest.PrivateIpAddress = &[]string{privateIPAddress}
+
+	networkInterfaces, err := client.DescribeNetworkInterfaces(ctx, describeNetworkInterfacesRequest)
+	if err != nil {
+		return fmt.Errorf("error describing network interfaces for private IP address %s: %v", privateIPAddress, err)
+	}
+
+	if networkInterfaces != nil && networkInterfaces.TotalCount > 0 {
+		return fmt.Errorf("private IP address %s is already in use in vswitch %s", privateIPAddress, vSwitchID)
+	}
+
+	return nil
+}
+
 func buildDescribeVSwitchesTag(tags []machinev1.Tag) *[]vpc.DescribeVSwitchesTag {
 	describeVSwitchesTag := make([]vpc.DescribeVSwitchesTag, len(tags))
 
@@ -492,16 +1685,84 @@ func buildDescribeVSwitchesTag(tags []machinev1.Tag) *[]vpc.DescribeVSwitchesTag
 	return &describeVSwitchesTag
 }
 
+// defaultRegionFromInfrastructure reads the region operators set on the cluster-scoped
+// Infrastructure object's AlibabaCloud platform status, so a machine's providerSpec can leave
+// RegionID empty instead of repeating it in every machineset template. It returns an empty
+// string, rather than an error, when the platform status has no AlibabaCloud section, leaving
+// RegionID validation to the caller.
+func defaultRegionFromInfrastructure(ctx context.Context, k8sClient runtimeclient.Client) (string, error) {
+	infra := &configv1.Infrastructure{}
+	if err := k8sClient.Get(ctx, runtimeclient.ObjectKey{Name: infrastructureResourceName}, infra); err != nil {
+		return "", fmt.Errorf("failed to get infrastructure object: %w", err)
+	}
+
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return "", nil
+	}
+
+	return infra.Status.PlatformStatus.AlibabaCloud.Region, nil
+}
+
+// defaultResourceGroupFromInfrastructure reads the resource group operators set on the
+// cluster-scoped Infrastructure object's AlibabaCloud platform status, so a machine's
+// providerSpec can leave ResourceGroupID empty and still have its resources created, and
+// discovered security groups and vswitches validated, against the cluster's own resource group.
+// It returns an empty string, rather than an error, when the platform status has no AlibabaCloud
+// section, leaving ResourceGroupID optional as before.
+func defaultResourceGroupFromInfrastructure(ctx context.Context, k8sClient runtimeclient.Client) (string, error) {
+	infra := &configv1.Infrastructure{}
+	if err := k8sClient.Get(ctx, runtimeclient.ObjectKey{Name: infrastructureResourceName}, infra); err != nil {
+		return "", fmt.Errorf("failed to get infrastructure object: %w", err)
+	}
+
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return "", nil
+	}
+
+	return infra.Status.PlatformStatus.AlibabaCloud.ResourceGroupID, nil
+}
+
+// getInfrastructureResourceTags reads the global resource tags operators set on the cluster-scoped
+// Infrastructure object's AlibabaCloud platform status, so day-2 tag policy changes reach machines
+// without editing every machineset. It returns an empty list, rather than an error, when the
+// platform status has no AlibabaCloud section (e.g. in unit tests that don't seed one).
+func getInfrastructureResourceTags(ctx context.Context, k8sClient runtimeclient.Client) ([]machinev1.Tag, error) {
+	infra := &configv1.Infrastructure{}
+	if err := k8sClient.Get(ctx, runtimeclient.ObjectKey{Name: infrastructureResourceName}, infra); err != nil {
+		return nil, fmt.Errorf("failed to get infrastructure object: %w", err)
+	}
+
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return nil, nil
+	}
+
+	tags := make([]machinev1.Tag, 0, len(infra.Status.PlatformStatus.AlibabaCloud.ResourceTags))
+	for _, tag := range infra.Status.PlatformStatus.AlibabaCloud.ResourceTags {
+		tags = append(tags, machinev1.Tag{Key: tag.Key, Value: tag.Value})
+	}
+
+	return tags, nil
+}
+
 // buildTagList compile a list of ecs tags from machine provider spec and infrastructure object platform spec
-func buildTagList(machineName string, clusterID string, machineTags []machinev1.Tag) []*machinev1.Tag {
+func buildTagList(machineName string, clusterID string, machineTags []machinev1.Tag, infrastructureTags []machinev1.Tag) []*machinev1.Tag {
 	rawTagList := make([]*machinev1.Tag, 0)
 
+	// machineTags is added before infrastructureTags so a machine's own providerSpec.Tags take
+	// precedence over the cluster-wide infrastructure tags in removeDuplicatedTags below when the
+	// same key is set in both places.
 	for _, tag := range machineTags {
 		// Alibabacoud tags are case sensitive, so we don't need to worry about other casing of "Name"
 		if !strings.HasPrefix(tag.Key, clusterFilterKeyPrefix) && tag.Key != clusterFilterName {
 			rawTagList = append(rawTagList, &machinev1.Tag{Key: tag.Key, Value: tag.Value})
 		}
 	}
+
+	for _, tag := range infrastructureTags {
+		if !strings.HasPrefix(tag.Key, clusterFilterKeyPrefix) && tag.Key != clusterFilterName {
+			rawTagList = append(rawTagList, &machinev1.Tag{Key: tag.Key, Value: tag.Value})
+		}
+	}
 	rawTagList = append(rawTagList, []*machinev1.Tag{
 		{Key: clusterFilterKeyPrefix + clusterID, Value: clusterFilterValue},
 		{Key: clusterFilterName, Value: machineName},
@@ -541,17 +1802,17 @@ func covertToRunInstancesTag(tags []*machinev1.Tag) *[]ecs.RunInstancesTag {
 	return &runInstancesTags
 }
 
-func getExistingInstanceByID(instanceID string, regionID string, client alibabacloudClient.Client) (*ecs.Instance, error) {
-	return getInstanceByID(instanceID, regionID, client, supportedInstanceStates())
+func getExistingInstanceByID(ctx context.Context, instanceID string, regionID string, client alibabacloudClient.Client, providerSpec *machinev1.AlibabaCloudMachineProviderConfig) (*ecs.Instance, error) {
+	return getInstanceByID(ctx, instanceID, regionID, client, supportedInstanceStates(providerSpec))
 }
 
 // getInstanceByID returns the instance with the given ID if it exists.
-func getInstanceByID(instanceID string, regionID string, client alibabacloudClient.Client, instanceStates []string) (*ecs.Instance, error) {
+func getInstanceByID(ctx context.Context, instanceID string, regionID string, client alibabacloudClient.Client, instanceStates []string) (*ecs.Instance, error) {
 	if instanceID == "" {
 		return nil, fmt.Errorf("instance-id not specified")
 	}
 
-	instances, err := describeInstances([]string{instanceID}, regionID, client)
+	instances, err := describeInstances(ctx, []string{instanceID}, regionID, client)
 	if err != nil {
 		return nil, err
 	}
@@ -564,7 +1825,7 @@ func getInstanceByID(instanceID string, regionID string, client alibabacloudClie
 	return &instance, instanceHasSupportedState(&instance, instanceStates)
 }
 
-func describeInstances(instanceIds []string, regionID string, client alibabacloudClient.Client) ([]ecs.Instance, error) {
+func describeInstances(ctx context.Context, instanceIds []string, regionID string, client alibabacloudClient.Client) ([]ecs.Instance, error) {
 	if len(instanceIds) < 1 {
 		return nil, fmt.Errorf("instance-ids not specified")
 	}
@@ -575,12 +1836,84 @@ func describeInstances(instanceIds []string, regionID string, client alibabaclou
 	instancesIds, _ := json.Marshal(instanceIds)
 	describeInstancesRequest.InstanceIds = string(instancesIds)
 
-	result, err := client.DescribeInstances(describeInstancesRequest)
+	cacheKey := fmt.Sprintf("id|%s|%s", regionID, describeInstancesRequest.InstanceIds)
+	return describeAllInstancesCached(ctx, cacheKey, client, describeInstancesRequest)
+}
+
+// instanceCacheTTL bounds how long a DescribeInstances result is reused across calls within the
+// same reconcile: Exists, Update and the getMachineInstances call inside UpdateMachine each
+// describe the same instance(s) moments apart, so caching for a few seconds halves ECS API
+// traffic without risking reconciling against stale data for any longer than that.
+const instanceCacheTTL = 5 * time.Second
+
+type instanceCacheEntry struct {
+	instances []ecs.Instance
+	expiresAt time.Time
+}
+
+var (
+	instanceCacheMu    sync.Mutex
+	instanceCacheByKey = make(map[string]instanceCacheEntry)
+)
+
+// describeAllInstancesCached wraps describeAllInstances with a short-TTL cache keyed by cacheKey,
+// so repeated lookups for the same instance-ids or tag filter within instanceCacheTTL reuse the
+// previous result instead of issuing another DescribeInstances call.
+func describeAllInstancesCached(ctx context.Context, cacheKey string, client alibabacloudClient.Client, request *ecs.DescribeInstancesRequest) ([]ecs.Instance, error) {
+	instanceCacheMu.Lock()
+	if entry, ok := instanceCacheByKey[cacheKey]; ok && time.Now().Before(entry.expiresAt) {
+		instanceCacheMu.Unlock()
+		return entry.instances, nil
+	}
+	instanceCacheMu.Unlock()
+
+	instances, err := describeAllInstances(ctx, client, request)
 	if err != nil {
 		return nil, err
 	}
 
-	return result.Instances.Instance, nil
+	instanceCacheMu.Lock()
+	now := time.Now()
+	// Sweep every expired entry, not just cacheKey's, on each miss. Keys stop being queried
+	// entirely when a machine is deleted or renamed, so without this the map would otherwise grow
+	// without bound as machines churn over the life of the process.
+	for key, entry := range instanceCacheByKey {
+		if now.After(entry.expiresAt) {
+			delete(instanceCacheByKey, key)
+		}
+	}
+	instanceCacheByKey[cacheKey] = instanceCacheEntry{instances: instances, expiresAt: now.Add(instanceCacheTTL)}
+	instanceCacheMu.Unlock()
+
+	return instances, nil
+}
+
+// describeInstancesPageSize is the page size requested of DescribeInstances; describeAllInstances
+// pages through PageNumber at this size so callers see every matching instance, not just the
+// first page, for clusters with more than one page of results.
+const describeInstancesPageSize = 100
+
+// describeAllInstances pages through DescribeInstances with request, accumulating every matching
+// instance across however many pages the result spans.
+func describeAllInstances(ctx context.Context, client alibabacloudClient.Client, request *ecs.DescribeInstancesRequest) ([]ecs.Instance, error) {
+	request.PageSize = requests.NewInteger(describeInstancesPageSize)
+
+	instances := make([]ecs.Instance, 0)
+
+	for pageNumber := 1; ; pageNumber++ {
+		request.PageNumber = requests.NewInteger(pageNumber)
+
+		response, err := client.DescribeInstances(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, response.Instances.Instance...)
+
+		if len(response.Instances.Instance) < describeInstancesPageSize || len(instances) >= response.TotalCount {
+			return instances, nil
+		}
+	}
 }
 
 func instanceHasSupportedState(instance *ecs.Instance, instanceStates []string) error {
@@ -611,13 +1944,16 @@ func instanceHasSupportedState(instance *ecs.Instance, instanceStates []string)
 }
 
 // getExistingInstances returns all instances not terminated
-func getExistingInstances(machine *machinev1beta1.Machine, regionID string, client alibabacloudClient.Client) ([]*ecs.Instance, error) {
-	return getInstances(machine, regionID, client, supportedInstanceStates())
+func getExistingInstances(ctx context.Context, machine *machinev1beta1.Machine, regionID string, client alibabacloudClient.Client, providerSpec *machinev1.AlibabaCloudMachineProviderConfig) ([]*ecs.Instance, error) {
+	return getInstances(ctx, machine, regionID, client, supportedInstanceStates(providerSpec))
 }
 
-// getInstances returns all instances that have a tag matching our machine name,
-// and cluster ID.
-func getInstances(machine *machinev1beta1.Machine, regionID string, client alibabacloudClient.Client, instanceStates []string) ([]*ecs.Instance, error) {
+// getInstances returns all instances that have a tag matching our machine name, cluster ID and
+// the clusterOwnedKey ownership tag, all three being the tags tagResourceTags applies when an
+// instance is created, so an identically named instance from another cluster or resource group
+// sharing the account is never matched; RegionId on the request further scopes the search to the
+// machine's own region.
+func getInstances(ctx context.Context, machine *machinev1beta1.Machine, regionID string, client alibabacloudClient.Client, instanceStates []string) ([]*ecs.Instance, error) {
 	clusterID, ok := getClusterID(machine)
 	if !ok {
 		return nil, fmt.Errorf("unable to get cluster ID for machine: %q", machine.Name)
@@ -628,18 +1964,20 @@ func getInstances(machine *machinev1beta1.Machine, regionID string, client aliba
 	describeInstancesTags := []ecs.DescribeInstancesTag{
 		{Key: clusterFilterKeyPrefix + clusterID, Value: clusterFilterValue},
 		{Key: clusterFilterName, Value: machine.Name},
+		{Key: clusterOwnedKey, Value: clusterOwnedValue},
 	}
 
 	request.Tag = &describeInstancesTags
 
-	result, err := client.DescribeInstances(request)
+	cacheKey := fmt.Sprintf("tag|%s|%s|%s", regionID, clusterID, machine.Name)
+	result, err := describeAllInstancesCached(ctx, cacheKey, client, request)
 	if err != nil {
 		return nil, err
 	}
 
 	instances := make([]*ecs.Instance, 0)
 
-	for _, instance := range result.Instances.Instance {
+	for _, instance := range result {
 		err := instanceHasSupportedState(&instance, instanceStates)
 		if err != nil {
 			klog.Errorf("Excluding instance matching %s: %v", machine.Name, err)
@@ -652,7 +1990,7 @@ func getInstances(machine *machinev1beta1.Machine, regionID string, client aliba
 }
 
 // stopInstances stop all provided instances with a single ECS request.
-func stopInstances(client alibabacloudClient.Client, regionID string, instances []*ecs.Instance) ([]ecs.InstanceResponse, error) {
+func stopInstances(ctx context.Context, client alibabacloudClient.Client, regionID string, stoppedMode string, instances []*ecs.Instance) ([]ecs.InstanceResponse, error) {
 	instanceIDs := make([]string, 0)
 	// Stop all older instances:
 	for _, instance := range instances {
@@ -661,7 +1999,7 @@ func stopInstances(client alibabacloudClient.Client, regionID string, instances
 	}
 
 	// Describe instances ,only running instance can be stopped
-	existingInstances, err := describeInstances(instanceIDs, regionID, client)
+	existingInstances, err := describeInstances(ctx, instanceIDs, regionID, client)
 	if err != nil {
 		klog.Errorf("failed to describe instances %v", err)
 		return nil, err
@@ -687,8 +2025,13 @@ func stopInstances(client alibabacloudClient.Client, regionID string, instances
 	stopInstancesRequest.RegionId = regionID
 	stopInstancesRequest.Scheme = "https"
 	stopInstancesRequest.InstanceId = &needStoppedInstanceIDs
+	// Bare metal instances do not support stop-charging mode; leaving StoppedMode unset stops
+	// the instance normally without attempting to pause billing.
+	if stoppedMode != "" && !isBareMetalInstanceType(existingInstances[0].InstanceType) {
+		stopInstancesRequest.StoppedMode = stoppedMode
+	}
 
-	stopInstancesResponse, err := client.StopInstances(stopInstancesRequest)
+	stopInstancesResponse, err := client.StopInstances(ctx, stopInstancesRequest)
 	if err != nil {
 		klog.Errorf("Error stopping instances: %v", err)
 		return nil, fmt.Errorf("error stopping instances: %v", err)
@@ -756,9 +2099,91 @@ func getRunningFromInstances(instances []*ecs.Instance) []*ecs.Instance {
 	return runningInstances
 }
 
+// allocateAndAssociateEIP allocates an EIP according to the machine's EIP configuration and
+// associates it with the given instance, returning the allocation ID of the EIP so it can be
+// released again when the machine is deleted.
+func allocateAndAssociateEIP(ctx context.Context, machineProviderConfig *machinev1.AlibabaCloudMachineProviderConfig, instanceID string, client alibabacloudClient.Client) (string, error) {
+	eip := machineProviderConfig.EIP
+
+	allocateRequest := vpc.CreateAllocateEipAddressRequest()
+	allocateRequest.Scheme = "https"
+	allocateRequest.RegionId = machineProviderConfig.RegionID
+
+	if eip.Bandwidth > 0 {
+		allocateRequest.Bandwidth = strconv.FormatInt(eip.Bandwidth, 10)
+	}
+
+	if eip.ISP != "" {
+		allocateRequest.ISP = eip.ISP
+	}
+
+	if eip.InstanceChargeType != "" {
+		allocateRequest.InstanceChargeType = eip.InstanceChargeType
+	}
+
+	allocateResponse, err := client.AllocateEipAddress(ctx, allocateRequest)
+	if err != nil {
+		klog.Errorf("Error allocating EIP for instance %s: %v", instanceID, err)
+		return "", fmt.Errorf("error allocating EIP: %v", err)
+	}
+
+	associateRequest := vpc.CreateAssociateEipAddressRequest()
+	associateRequest.Scheme = "https"
+	associateRequest.AllocationId = allocateResponse.AllocationId
+	associateRequest.InstanceId = instanceID
+
+	if _, err := client.AssociateEipAddress(ctx, associateRequest); err != nil {
+		klog.Errorf("Error associating EIP %s with instance %s: %v", allocateResponse.AllocationId, instanceID, err)
+		return "", fmt.Errorf("error associating EIP: %v", err)
+	}
+
+	return allocateResponse.AllocationId, nil
+}
+
+// releaseEIP unassociates and releases the EIP identified by allocationID from the given instance.
+func releaseEIP(ctx context.Context, allocationID string, instanceID string, client alibabacloudClient.Client) error {
+	unassociateRequest := vpc.CreateUnassociateEipAddressRequest()
+	unassociateRequest.Scheme = "https"
+	unassociateRequest.AllocationId = allocationID
+	unassociateRequest.InstanceId = instanceID
+
+	if _, err := client.UnassociateEipAddress(ctx, unassociateRequest); err != nil {
+		klog.Errorf("Error unassociating EIP %s from instance %s: %v", allocationID, instanceID, err)
+		return fmt.Errorf("error unassociating EIP: %v", err)
+	}
+
+	releaseRequest := vpc.CreateReleaseEipAddressRequest()
+	releaseRequest.Scheme = "https"
+	releaseRequest.AllocationId = allocationID
+
+	if _, err := client.ReleaseEipAddress(ctx, releaseRequest); err != nil {
+		klog.Errorf("Error releasing EIP %s: %v", allocationID, err)
+		return fmt.Errorf("error releasing EIP: %v", err)
+	}
+
+	return nil
+}
+
+// clearDeletionProtection disables deletion protection on the given instances so a subsequent
+// DeleteInstances call initiated by machine-api is not rejected by ECS.
+func clearDeletionProtection(ctx context.Context, instances []*ecs.Instance, client alibabacloudClient.Client) error {
+	for _, instance := range instances {
+		request := ecs.CreateModifyInstanceAttributeRequest()
+		request.Scheme = "https"
+		request.InstanceId = instance.InstanceId
+		request.DeletionProtection = requests.NewBoolean(false)
+
+		if _, err := client.ModifyInstanceAttribute(ctx, request); err != nil {
+			return fmt.Errorf("error clearing deletion protection on instance %s: %v", instance.InstanceId, err)
+		}
+	}
+
+	return nil
+}
+
 // correctExistingTags validates Name and clusterID tags are correct on the instance
 // and sets them if they are not.
-func correctExistingTags(machine *machinev1beta1.Machine, regionID string, instance *ecs.Instance, client alibabacloudClient.Client) error {
+func correctExistingTags(ctx context.Context, k8sClient runtimeclient.Client, machine *machinev1beta1.Machine, regionID string, providerSpec *machinev1.AlibabaCloudMachineProviderConfig, instance *ecs.Instance, client alibabacloudClient.Client, eventRecorder record.EventRecorder) error {
 	// https://www.alibabacloud.com/help/en/doc-detail/110424.htm
 	if instance == nil || instance.InstanceId == "" {
 		return fmt.Errorf("unexpected nil found in instance: %v", instance)
@@ -770,8 +2195,10 @@ func correctExistingTags(machine *machinev1beta1.Machine, regionID string, insta
 	nameTagOk := false
 	clusterTagOk := false
 	ownedTagOk := false
+	existing := make(map[string]string, len(instance.Tags.Tag))
 	for _, tag := range instance.Tags.Tag {
 		if tag.TagKey != "" && tag.TagValue != "" {
+			existing[tag.TagKey] = tag.TagValue
 			if tag.TagKey == clusterFilterName && tag.TagValue == machine.Name {
 				nameTagOk = true
 			}
@@ -784,20 +2211,69 @@ func correctExistingTags(machine *machinev1beta1.Machine, regionID string, insta
 		}
 	}
 
-	// Update our tags if they're not set or correct
-	if !nameTagOk || !clusterTagOk || !ownedTagOk {
+	infrastructureTags, err := getInfrastructureResourceTags(ctx, k8sClient)
+	if err != nil {
+		klog.Errorf("%s: failed to read infrastructure resource tags: %v", machine.Name, err)
+	}
+
+	missingTags := make([]ecs.TagResourcesTag, 0)
+	for _, tag := range append(append([]machinev1.Tag{}, providerSpec.Tags...), infrastructureTags...) {
+		if existing[tag.Key] != tag.Value {
+			missingTags = append(missingTags, ecs.TagResourcesTag{Key: tag.Key, Value: tag.Value})
+		}
+	}
+
+	// Update our tags if any of the cluster tags, user-specified providerSpec tags, or
+	// cluster-wide infrastructure resource tags are missing or out of date, so tag policy changes
+	// propagate to already-running instances instead of only taking effect on instances created
+	// afterwards.
+	// Stale cluster-managed tags (present on the instance but no longer in providerSpec.Tags) are
+	// left alone: there is no record of which tags this actuator previously applied versus which
+	// were added to the instance out of band, so removing them could delete a tag nothing here
+	// actually owns.
+	if !nameTagOk || !clusterTagOk || !ownedTagOk || len(missingTags) > 0 {
 		// Create tags only adds/replaces what is present, does not affect other tags.
 		request := ecs.CreateTagResourcesRequest()
 		request.Scheme = "https"
 		request.RegionId = regionID
-		request.Tag = tagResourceTags(clusterID, machine.Name)
+		tags := append(*tagResourceTags(clusterID, machine.Name), missingTags...)
+		request.Tag = &tags
 		request.ResourceId = &[]string{instance.InstanceId}
 		request.ResourceType = ECSTagResourceTypeInstance
 
 		klog.Infof("Invalid or missing instance tags for machine: %v; instanceID: %v, updating", machine.Name, instance.InstanceId)
-		_, err := client.TagResources(request)
-		return err
+		_, err := client.TagResources(ctx, request)
+		if err != nil {
+			if eventRecorder != nil {
+				eventRecorder.Eventf(machine, corev1.EventTypeWarning, "TagFailed", "failed to tag instance %s: %v%s", instance.InstanceId, err, requestIDSuffix(err))
+			}
+			return err
+		}
+
+		if eventRecorder != nil {
+			eventRecorder.Eventf(machine, corev1.EventTypeNormal, "Tagged", "updated tags on instance %s", instance.InstanceId)
+		}
 	}
 
 	return nil
 }
+
+// untagInstanceOwnership removes the cluster/machine ownership tags this actuator placed on
+// instanceID via tagResourceTags, without deleting the instance itself. It is used when a Machine
+// is deleted with skipInstanceDeletionAnnotation set, so the instance stops being claimed by this
+// cluster but is otherwise left untouched for reuse elsewhere or a post-mortem.
+func untagInstanceOwnership(ctx context.Context, clusterID, regionID, instanceID string, client alibabacloudClient.Client) error {
+	request := ecs.CreateUntagResourcesRequest()
+	request.Scheme = "https"
+	request.RegionId = regionID
+	request.ResourceId = &[]string{instanceID}
+	request.ResourceType = ECSTagResourceTypeInstance
+	request.TagKey = &[]string{
+		clusterFilterKeyPrefix + clusterID,
+		clusterFilterName,
+		clusterOwnedKey,
+	}
+
+	_, err := client.UntagResources(ctx, request)
+	return err
+}
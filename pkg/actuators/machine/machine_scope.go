@@ -25,7 +25,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/klog"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
 
 	machinev1 "github.com/openshift/api/machine/v1"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
@@ -49,6 +50,10 @@ type machineScope struct {
 	machineToBePatched runtimeclient.Patch
 	providerSpec       *machinev1.AlibabaCloudMachineProviderConfig
 	providerStatus     *machinev1.AlibabaCloudMachineProviderStatus
+	eventRecorder      record.EventRecorder
+	// log is a structured logger carrying this machine's name, namespace and (once known)
+	// instanceID, for correlating log lines across a single reconcile.
+	log *machineLogger
 }
 
 // machineScopeParams defines the input parameters used to create a new MachineScope.
@@ -62,6 +67,8 @@ type machineScopeParams struct {
 	machine *machinev1beta1.Machine
 	// api server controller runtime client for the openshift-config-managed namespace
 	configManagedClient runtimeclient.Client
+	// eventRecorder used to publish Kubernetes events against the machine resource
+	eventRecorder record.EventRecorder
 }
 
 // newMachineScope init machineScope instance
@@ -76,6 +83,25 @@ func newMachineScope(params machineScopeParams) (*machineScope, error) {
 		return nil, machineapierros.InvalidMachineConfiguration("failed to get machine provider status: %v", err.Error())
 	}
 
+	if providerSpec.RegionID == "" {
+		region, err := defaultRegionFromInfrastructure(params.Context, params.client)
+		if err != nil {
+			return nil, machineapierros.InvalidMachineConfiguration("failed to default regionID from infrastructure: %v", err)
+		}
+		if region == "" {
+			return nil, machineapierros.InvalidMachineConfiguration("regionID not set and cluster infrastructure has no AlibabaCloud platform status to default it from")
+		}
+		providerSpec.RegionID = region
+	}
+
+	if providerSpec.ResourceGroupID == "" {
+		resourceGroupID, err := defaultResourceGroupFromInfrastructure(params.Context, params.client)
+		if err != nil {
+			return nil, machineapierros.InvalidMachineConfiguration("failed to default resourceGroupID from infrastructure: %v", err)
+		}
+		providerSpec.ResourceGroupID = resourceGroupID
+	}
+
 	credentialsSecretName := ""
 	if providerSpec.CredentialsSecret != nil {
 		credentialsSecretName = providerSpec.CredentialsSecret.Name
@@ -86,6 +112,10 @@ func newMachineScope(params machineScopeParams) (*machineScope, error) {
 		return nil, machineapierros.InvalidMachineConfiguration("failed to create alibabacloud client: %v", err)
 	}
 
+	if err := alibabacloudClient.ValidateCredentials(params.Context, aliClient, providerSpec.RegionID); err != nil {
+		return nil, machineapierros.InvalidMachineConfiguration("%v", err)
+	}
+
 	return &machineScope{
 		Context:            params.Context,
 		alibabacloudClient: aliClient,
@@ -94,6 +124,8 @@ func newMachineScope(params machineScopeParams) (*machineScope, error) {
 		machineToBePatched: runtimeclient.MergeFrom(params.machine.DeepCopy()),
 		providerSpec:       providerSpec,
 		providerStatus:     providerStatus,
+		eventRecorder:      params.eventRecorder,
+		log:                newMachineLogger(params.machine),
 	}, nil
 }
 
@@ -152,6 +184,33 @@ func (s *machineScope) getUserData() (string, error) {
 	return base64.StdEncoding.EncodeToString(userData), nil
 }
 
+// getPassword fetches the login password from the secret referenced in the Machine's provider
+// spec, if one is set. This is primarily used to set the administrator password on Windows
+// instances.
+func (s *machineScope) getPassword() (string, error) {
+	if s.providerSpec == nil || s.providerSpec.PasswordSecret == nil {
+		return "", nil
+	}
+
+	passwordSecret := &corev1.Secret{}
+
+	objKey := runtimeclient.ObjectKey{
+		Namespace: s.machine.Namespace,
+		Name:      s.providerSpec.PasswordSecret.Name,
+	}
+
+	if err := s.client.Get(s.Context, objKey, passwordSecret); err != nil {
+		return "", fmt.Errorf("error getting password secret %s in namespace %s: %w", s.providerSpec.PasswordSecret.Name, s.providerSpec.GetNamespace(), err)
+	}
+
+	password, exists := passwordSecret.Data[passwordSecretKey]
+	if !exists {
+		return "", fmt.Errorf("secret %v/%v does not have password field set", s.providerSpec.GetNamespace(), s.providerSpec.PasswordSecret.Name)
+	}
+
+	return string(password), nil
+}
+
 func (s *machineScope) setProviderStatus(instance *ecs.Instance, condition metav1.Condition) error {
 	klog.Infof("%s: Updating status", s.machine.Name)
 
@@ -159,9 +218,32 @@ func (s *machineScope) setProviderStatus(instance *ecs.Instance, condition metav
 	if instance == nil {
 		s.providerStatus.InstanceID = nil
 		s.providerStatus.InstanceState = nil
+		s.providerStatus.Zone = nil
+		s.providerStatus.InstanceType = nil
+		s.providerStatus.ImageID = nil
+		s.providerStatus.PrivateIP = nil
+		s.providerStatus.PublicIP = nil
+		s.providerStatus.EIP = nil
+		s.providerStatus.IPv6Addresses = nil
 	} else {
 		s.providerStatus.InstanceID = &instance.InstanceId
 		s.providerStatus.InstanceState = &instance.Status
+		s.providerStatus.Zone = &instance.ZoneId
+		s.providerStatus.InstanceType = &instance.InstanceType
+		s.providerStatus.ImageID = &instance.ImageId
+		s.providerStatus.PrivateIP = nil
+		if privateIP := instance.VpcAttributes.PrivateIpAddress.IpAddress; len(privateIP) > 0 && privateIP[0] != "" {
+			s.providerStatus.PrivateIP = &privateIP[0]
+		}
+		s.providerStatus.PublicIP = nil
+		if publicIPs := instance.PublicIpAddress.IpAddress; len(publicIPs) > 0 && publicIPs[0] != "" {
+			s.providerStatus.PublicIP = &publicIPs[0]
+		}
+		s.providerStatus.EIP = nil
+		if eip := instance.EipAddress.IpAddress; eip != "" {
+			s.providerStatus.EIP = &eip
+		}
+		s.providerStatus.IPv6Addresses = extractIPv6AddressesFromInstance(instance)
 	}
 
 	networkAddresses, err := s.getNetworkAddress(instance)
@@ -242,5 +324,31 @@ func extractNodeAddressesFromInstance(instance *ecs.Instance) ([]corev1.NodeAddr
 		}
 	}
 
+	// an Elastic IP Address is bound separately from the instance's own public IP pool address, so it
+	// is not covered by the PublicIpAddress handling above.
+	if eipAddress := instance.EipAddress.IpAddress; eipAddress != "" {
+		ip := net.ParseIP(eipAddress)
+		if ip == nil {
+			return nil, fmt.Errorf("ECS instance had invalid EIP address: %s (%s)", instance.InstanceId, eipAddress)
+		}
+		addresses = append(addresses, corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: ip.String()})
+	}
+
 	return addresses, nil
 }
+
+// extractIPv6AddressesFromInstance collects the IPv6 addresses assigned to instance's network
+// interfaces, for reporting on providerStatus.IPv6Addresses.
+func extractIPv6AddressesFromInstance(instance *ecs.Instance) []string {
+	addresses := make([]string, 0)
+
+	for _, networkInterface := range instance.NetworkInterfaces.NetworkInterface {
+		for _, ipv6Address := range networkInterface.Ipv6Sets.Ipv6Set {
+			if addr := ipv6Address.Ipv6Address; addr != "" {
+				addresses = append(addresses, addr)
+			}
+		}
+	}
+
+	return addresses
+}
@@ -19,21 +19,105 @@ package machine
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 
-	"k8s.io/klog"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
 
 	"github.com/openshift/machine-api-operator/pkg/metrics"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
+	machinev1 "github.com/openshift/api/machine/v1"
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
 	machinecontroller "github.com/openshift/machine-api-operator/pkg/controller/machine"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// instanceProvisioningDuration records the time from instance creation to the instance first
+// being observed as Running, labeled by instance type and zone, so platform teams can track
+// Alibaba Cloud provisioning latency regressions.
+var instanceProvisioningDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "alibabacloud_instance_provisioning_duration_seconds",
+		Help:    "Time from instance creation to the instance reaching Running, labeled by instance type and zone.",
+		Buckets: []float64{5, 10, 20, 30, 60, 90, 120, 180, 240, 300, 420, 600, 900},
+	}, []string{"instance_type", "zone"},
+)
+
+func init() {
+	prometheus.MustRegister(instanceProvisioningDuration)
+}
+
 const (
 	requeueAfterSeconds      = 20
 	requeueAfterFatalSeconds = 180
 	masterLabel              = "node-role.kubernetes.io/master"
+
+	// createBackoffBaseSeconds and createBackoffCapSeconds bound the exponential backoff applied
+	// between RunInstances retries for a machine with consecutive create failures, so a
+	// persistent quota or stock-out error does not hot-loop ECS API calls.
+	createBackoffBaseSeconds = 15
+	createBackoffCapSeconds  = 300
+
+	// powerStateAnnotation drives cluster hibernation: setting it to powerStateStopped on a
+	// Machine stops its ECS instance instead of leaving it running, and clearing it (or setting
+	// it to powerStateRunning) starts the instance back up.
+	powerStateAnnotation = "machine.openshift.io/power-state"
+	powerStateStopped    = "Stopped"
+	powerStateRunning    = "Running"
+
+	// rebootAnnotation lets an external remediation controller (e.g. MachineHealthCheck's
+	// external remediation) request a power-cycle of the instance backing this Machine. The
+	// Reconciler clears the annotation once the reboot has been issued.
+	rebootAnnotation = "machine.openshift.io/remediate-reboot"
+
+	// skipInstanceDeletionAnnotation lets an operator move an instance to another cluster, or keep
+	// it around for a node post-mortem, by deleting the Machine without deleting its ECS instance.
+	// DeleteMachine only removes the cluster/machine tags it owns and the Machine's finalizer; the
+	// instance itself is left running and untouched.
+	skipInstanceDeletionAnnotation = "machine.openshift.io/skip-instance-deletion"
+
+	// allowInstanceResizeAnnotation opts a Machine in to in-place InstanceType changes: without it,
+	// an InstanceType change in the provider spec is only reported by reconcileDrift and otherwise
+	// ignored until the machine is replaced, since stopping a node to resize it is disruptive enough
+	// that it should not happen without the operator asking for it.
+	allowInstanceResizeAnnotation = "machine.openshift.io/allow-instance-resize"
+
+	// instanceHealthConditionType reports ECS hardware failure and maintenance events observed
+	// for the instance backing this Machine.
+	instanceHealthConditionType = "InstanceHealth"
+
+	// diskResizeConditionType reports the progress of online disk resizes requested by growing
+	// a disk's Size in the provider spec.
+	diskResizeConditionType = "DiskResize"
+
+	// driftConditionType reports whether the live instance still matches the key fields of the
+	// provider spec (instance type, security groups, vswitch, disk sizes, tags), so operators can
+	// tell when a machine has drifted from its spec without diffing it by hand.
+	driftConditionType = "MachineDrifted"
+
+	// providerIDConditionType reports whether machine.Spec.ProviderID matches the instance found
+	// via tags for this machine, so a stale providerID left over from an instance redeploy is
+	// visible before setProviderID rewrites it.
+	providerIDConditionType = "ProviderIDValid"
+
+	// gpuCountLabel and acceleratorTypeLabel expose the instance type's GPU topology on the
+	// Machine so workloads can be scheduled by accelerator count/model, mirroring how the AWS
+	// provider labels GPU-backed EC2 instances.
+	gpuCountLabel        = "machine.openshift.io/gpu-count"
+	acceleratorTypeLabel = "machine.openshift.io/accelerator-type"
+
+	// localStorageLabel marks a Machine as backed by an instance type with local (instance store)
+	// disks, whose data does not survive a stop/start or instance failure, so workloads requiring
+	// persistent storage can avoid scheduling onto these nodes.
+	localStorageLabel = "machine.openshift.io/local-storage"
 )
 
 // Reconciler runs the logic to reconciles a machine resource towards its desired state
@@ -50,19 +134,34 @@ func NewReconciler(scope *machineScope) *Reconciler {
 
 // Create creates machine if and only if machine exists, handled by cluster-api
 func (r *Reconciler) Create(ctx context.Context) error {
-	klog.Infof("%s: creating machine ", r.machine.Name)
+	r.log.Info("creating machine")
 
 	instance, err := r.CreateMachine(ctx)
 	if err != nil {
 		return err
 	}
 
-	klog.Infof("Created Machine %v", r.machine.Name)
+	r.log = r.log.withInstanceID(instance.InstanceId)
+	r.log.Info("created machine")
+	if r.eventRecorder != nil {
+		r.eventRecorder.Eventf(r.machine, corev1.EventTypeNormal, "Created", "created instance %s", instance.InstanceId)
+	}
+
+	r.recordProvisioningDuration(instance, r.providerStatus.InstanceState)
+
+	if r.providerSpec.EIP != nil {
+		allocationID, err := allocateAndAssociateEIP(ctx, r.providerSpec, instance.InstanceId, r.alibabacloudClient)
+		if err != nil {
+			return fmt.Errorf("failed to allocate and associate EIP: %w", err)
+		}
+		r.providerStatus.EIPAllocationID = &allocationID
+	}
+
 	if err = r.setProviderID(instance); err != nil {
 		return fmt.Errorf("failed to update machine object with providerID: %w", err)
 	}
 
-	if err = r.setMachineCloudProviderSpecifics(instance); err != nil {
+	if err = r.setMachineCloudProviderSpecifics(ctx, instance); err != nil {
 		return fmt.Errorf("failed to set machine cloud provider specifics: %w", err)
 	}
 
@@ -76,49 +175,103 @@ func (r *Reconciler) CreateMachine(ctx context.Context) (*ecs.Instance, error) {
 		return nil, fmt.Errorf("%v: failed validating machine provider spec: %w", r.machine.GetName(), err)
 	}
 
+	if wait := r.createBackoffRemaining(); wait > 0 {
+		r.log.Info("backing off before retrying create", "failedAttempts", r.providerStatus.FailedCreateAttempts, "wait", wait)
+		return nil, &machinecontroller.RequeueAfterError{RequeueAfter: wait}
+	}
+
 	userData, err := r.machineScope.getUserData()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user data: %w", err)
 	}
 
-	instance, err := runInstances(r.machine, r.providerSpec, userData, r.alibabacloudClient)
+	password, err := r.machineScope.getPassword()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password: %w", err)
+	}
+
+	instance, err := runInstances(ctx, r.client, r.machine, r.providerSpec, userData, password, r.alibabacloudClient)
 	if err != nil {
-		klog.Errorf("%s: error creating machine: %v", r.machine.Name, err)
+		r.log.Error(err, "error creating machine")
+		r.providerStatus.FailedCreateAttempts++
+		now := metav1.Now()
+		r.providerStatus.LastCreateFailureTime = &now
 		conditionFailed := conditionFailed()
 		conditionFailed.Message = err.Error()
 		_ = r.machineScope.setProviderStatus(nil, conditionFailed)
 		return nil, fmt.Errorf("failed to create instance: %w", err)
 	}
 
+	r.providerStatus.FailedCreateAttempts = 0
+	r.providerStatus.LastCreateFailureTime = nil
+
 	return instance, nil
 }
 
+// createBackoffRemaining returns how much longer CreateMachine should wait before retrying
+// RunInstances, based on providerStatus.FailedCreateAttempts and LastCreateFailureTime. It
+// returns zero once the backoff window for the current attempt count has elapsed, or
+// immediately when there have been no recent failures.
+func (r *Reconciler) createBackoffRemaining() time.Duration {
+	if r.providerStatus.FailedCreateAttempts == 0 || r.providerStatus.LastCreateFailureTime == nil {
+		return 0
+	}
+
+	backoff := time.Duration(createBackoffBaseSeconds) * time.Second
+	for i := int32(1); i < r.providerStatus.FailedCreateAttempts; i++ {
+		backoff *= 2
+		if backoff >= time.Duration(createBackoffCapSeconds)*time.Second {
+			backoff = time.Duration(createBackoffCapSeconds) * time.Second
+			break
+		}
+	}
+
+	// add up to 20% jitter so many machines failing at once don't retry in lockstep
+	backoff += time.Duration(rand.Int63n(int64(backoff) / 5))
+
+	elapsed := time.Since(r.providerStatus.LastCreateFailureTime.Time)
+	if elapsed >= backoff {
+		return 0
+	}
+
+	return backoff - elapsed
+}
+
 // Update updates machine if and only if machine exists, handled by cluster-api
 func (r *Reconciler) Update(ctx context.Context) error {
-	klog.Infof("%s: updating machine", r.machine.Name)
+	r.log.Info("updating machine")
 
+	previousInstanceState := r.providerStatus.InstanceState
 	instance, err := r.UpdateMachine(ctx)
 	if err != nil {
 		return err
 	}
 
+	r.log = r.log.withInstanceID(instance.InstanceId)
+	r.recordProvisioningDuration(instance, previousInstanceState)
+
 	if err = r.setProviderID(instance); err != nil {
 		return fmt.Errorf("failed to update machine object with providerID: %w", err)
 	}
 
-	if err = r.setMachineCloudProviderSpecifics(instance); err != nil {
+	if err = r.setMachineCloudProviderSpecifics(ctx, instance); err != nil {
 		return fmt.Errorf("failed to set machine cloud provider specifics: %w", err)
 	}
 
-	if err = correctExistingTags(r.machine, r.providerSpec.RegionID, instance, r.alibabacloudClient); err != nil {
+	if err = correctExistingTags(ctx, r.client, r.machine, r.providerSpec.RegionID, r.providerSpec, instance, r.alibabacloudClient, r.eventRecorder); err != nil {
+		metrics.RegisterFailedInstanceUpdate(&metrics.MachineLabels{
+			Name:      r.machine.Name,
+			Namespace: r.machine.Namespace,
+			Reason:    err.Error(),
+		})
 		return fmt.Errorf("failed to correct existing instance tags: %w", err)
 	}
 
-	klog.Infof("Updated machine %s", r.machine.Name)
+	r.log.Info("updated machine")
 
 	r.machineScope.setProviderStatus(instance, conditionSuccess())
 
-	return r.requeueIfInstancePending(instance)
+	return r.requeueIfInstancePending(ctx, instance)
 }
 
 func (r *Reconciler) UpdateMachine(ctx context.Context) (*ecs.Instance, error) {
@@ -127,7 +280,7 @@ func (r *Reconciler) UpdateMachine(ctx context.Context) (*ecs.Instance, error) {
 	}
 
 	// Get all instances not deleted.
-	existingInstances, err := r.getMachineInstances()
+	existingInstances, err := r.getMachineInstances(ctx)
 	if err != nil {
 		metrics.RegisterFailedInstanceUpdate(&metrics.MachineLabels{
 			Name:      r.machine.Name,
@@ -165,90 +318,768 @@ func (r *Reconciler) UpdateMachine(ctx context.Context) (*ecs.Instance, error) {
 		newestInstance = existingInstances[0]
 	}
 
+	if err := r.reconcilePowerState(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile power state: %w", err)
+	}
+
+	if err := r.reconcileRebootRequest(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile reboot request: %w", err)
+	}
+
+	if err := r.reconcileInstanceHealth(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile instance health: %w", err)
+	}
+
+	if err := r.reconcileMetadataOptions(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile metadata options: %w", err)
+	}
+
+	if err := r.reconcileNewDataDisks(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile new data disks: %w", err)
+	}
+
+	if err := r.reconcileDiskResize(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile disk resize: %w", err)
+	}
+
+	if err := r.reconcileSourceDestCheck(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile source/dest check: %w", err)
+	}
+
+	if err := r.reconcileSecurityGroups(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile security groups: %w", err)
+	}
+
+	if err := r.reconcileInstanceTypeResize(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile instance type resize: %w", err)
+	}
+
+	if err := r.reconcileDrift(ctx, newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile drift: %w", err)
+	}
+
+	if err := r.reconcileProviderID(newestInstance); err != nil {
+		return nil, fmt.Errorf("failed to reconcile providerID: %w", err)
+	}
+
+	if err := r.validateLoadBalancerServerGroups(); err != nil {
+		return nil, fmt.Errorf("failed to validate load balancer server groups: %w", err)
+	}
+
 	return newestInstance, nil
 }
 
-func (r *Reconciler) requeueIfInstancePending(instance *ecs.Instance) error {
+// validateLoadBalancerServerGroups checks the Type, ServerGroupID and Weight of each configured
+// LoadBalancerServerGroups entry.
+//
+// Note: actually registering the instance with the ALB or NLB server group is not implemented,
+// because neither the ALB nor the NLB service is vendored in this SDK version (only ecs, vpc and
+// slb are present under vendor/github.com/aliyun/alibaba-cloud-sdk-go/services); doing so would
+// require vendoring the corresponding SDK packages.
+func (r *Reconciler) validateLoadBalancerServerGroups() error {
+	for _, attachment := range r.providerSpec.LoadBalancerServerGroups {
+		switch attachment.Type {
+		case "ALB", "NLB":
+		default:
+			return fmt.Errorf("load balancer server group %s has unsupported type %q, expected ALB or NLB", attachment.ServerGroupID, attachment.Type)
+		}
+
+		if attachment.ServerGroupID == "" {
+			return fmt.Errorf("load balancer server group entry of type %s is missing a ServerGroupID", attachment.Type)
+		}
+
+		if attachment.Port <= 0 || attachment.Port > 65535 {
+			return fmt.Errorf("load balancer server group %s has invalid port %d", attachment.ServerGroupID, attachment.Port)
+		}
+
+		if attachment.Weight < 0 || attachment.Weight > 100 {
+			return fmt.Errorf("load balancer server group %s has invalid weight %d, expected 0 to 100", attachment.ServerGroupID, attachment.Weight)
+		}
+	}
+
+	return nil
+}
+
+// reconcilePowerState stops or starts the instance to match the machine's power-state
+// annotation, enabling cluster hibernation without deleting the underlying instance.
+func (r *Reconciler) reconcilePowerState(ctx context.Context, instance *ecs.Instance) error {
+	desiredState := r.machine.Annotations[powerStateAnnotation]
+
+	switch desiredState {
+	case powerStateStopped:
+		if instance.Status != ECSInstanceStatusRunning {
+			return nil
+		}
+		klog.Infof("%s: hibernating, stopping instance %s", r.machine.Name, instance.InstanceId)
+		if _, err := stopInstances(ctx, r.alibabacloudClient, r.providerSpec.RegionID, r.providerSpec.StoppedMode, []*ecs.Instance{instance}); err != nil {
+			metrics.RegisterFailedInstanceUpdate(&metrics.MachineLabels{
+				Name:      r.machine.Name,
+				Namespace: r.machine.Namespace,
+				Reason:    err.Error(),
+			})
+			return fmt.Errorf("failed to stop instance %s: %w", instance.InstanceId, err)
+		}
+		if r.eventRecorder != nil {
+			r.eventRecorder.Eventf(r.machine, corev1.EventTypeNormal, "Stopped", "stopped instance %s", instance.InstanceId)
+		}
+	case "", powerStateRunning:
+		if instance.Status != ECSInstanceStatusStopped {
+			return nil
+		}
+		klog.Infof("%s: resuming, starting instance %s", r.machine.Name, instance.InstanceId)
+		startInstanceRequest := ecs.CreateStartInstanceRequest()
+		startInstanceRequest.Scheme = "https"
+		startInstanceRequest.InstanceId = instance.InstanceId
+		if _, err := r.alibabacloudClient.StartInstance(ctx, startInstanceRequest); err != nil {
+			metrics.RegisterFailedInstanceUpdate(&metrics.MachineLabels{
+				Name:      r.machine.Name,
+				Namespace: r.machine.Namespace,
+				Reason:    err.Error(),
+			})
+			return fmt.Errorf("failed to start instance %s: %w", instance.InstanceId, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileRebootRequest services the reboot remediation annotation: when present, it
+// power-cycles the instance and clears the annotation so the request is not repeated.
+func (r *Reconciler) reconcileRebootRequest(ctx context.Context, instance *ecs.Instance) error {
+	if _, ok := r.machine.Annotations[rebootAnnotation]; !ok {
+		return nil
+	}
+
+	klog.Infof("%s: remediation requested, rebooting instance %s", r.machine.Name, instance.InstanceId)
+
+	rebootInstanceRequest := ecs.CreateRebootInstanceRequest()
+	rebootInstanceRequest.Scheme = "https"
+	rebootInstanceRequest.InstanceId = instance.InstanceId
+	if _, err := r.alibabacloudClient.RebootInstance(ctx, rebootInstanceRequest); err != nil {
+		return fmt.Errorf("failed to reboot instance %s: %w", instance.InstanceId, err)
+	}
+
+	delete(r.machine.Annotations, rebootAnnotation)
+
+	return nil
+}
+
+// reconcileInstanceHealth surfaces ECS-reported hardware failures and scheduled maintenance
+// events for the instance as a Machine condition, and redeploys the instance to different
+// hardware when a hardware failure event requires it.
+func (r *Reconciler) reconcileInstanceHealth(ctx context.Context, instance *ecs.Instance) error {
+	describeRequest := ecs.CreateDescribeInstancesFullStatusRequest()
+	describeRequest.Scheme = "https"
+	describeRequest.InstanceId = &[]string{instance.InstanceId}
+
+	response, err := r.alibabacloudClient.DescribeInstancesFullStatus(ctx, describeRequest)
+	if err != nil {
+		return fmt.Errorf("failed to describe instance full status for %s: %w", instance.InstanceId, err)
+	}
+
+	if response == nil || len(response.InstanceFullStatusSet.InstanceFullStatusType) == 0 {
+		return nil
+	}
+
+	fullStatus := response.InstanceFullStatusSet.InstanceFullStatusType[0]
+
+	condition := metav1.Condition{
+		Type:   instanceHealthConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: "InstanceHealthy",
+	}
+
+	needsRedeploy := false
+	var events []string
+	for _, event := range fullStatus.ScheduledSystemEventSet.ScheduledSystemEventType {
+		events = append(events, fmt.Sprintf("%s (%s)", event.EventType.Name, event.NotBefore))
+		if event.EventType.Name == hardwareFailureEventType {
+			needsRedeploy = true
+		}
+		if r.eventRecorder != nil {
+			r.eventRecorder.Eventf(r.machine, corev1.EventTypeWarning, "ScheduledMaintenance",
+				"instance %s has a scheduled %s event not before %s: %s", instance.InstanceId, event.EventType.Name, event.NotBefore, event.Reason)
+		}
+	}
+
+	if fullStatus.HealthStatus.Name != "" && fullStatus.HealthStatus.Name != instanceHealthStatusNormal || len(events) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "InstanceDegraded"
+		condition.Message = fmt.Sprintf("health status %s, scheduled events: %s", fullStatus.HealthStatus.Name, strings.Join(events, ", "))
+	}
+
+	if err := r.machineScope.setProviderStatus(instance, condition); err != nil {
+		return fmt.Errorf("failed to set instance health condition: %w", err)
+	}
+
+	if !needsRedeploy {
+		return nil
+	}
+
+	klog.Infof("%s: hardware failure detected, redeploying instance %s", r.machine.Name, instance.InstanceId)
+	redeployRequest := ecs.CreateRedeployInstanceRequest()
+	redeployRequest.Scheme = "https"
+	redeployRequest.InstanceId = instance.InstanceId
+	if _, err := r.alibabacloudClient.RedeployInstance(ctx, redeployRequest); err != nil {
+		return fmt.Errorf("failed to redeploy instance %s: %w", instance.InstanceId, err)
+	}
+
+	return nil
+}
+
+// reconcileMetadataOptions pushes the provider spec's IMDS hardening options to the instance via
+// ModifyInstanceMetadataOptions, so a later change to HTTPTokens/HTTPEndpoint/
+// HTTPPutResponseHopLimit takes effect on an already-running instance instead of only at creation.
+func (r *Reconciler) reconcileMetadataOptions(ctx context.Context, instance *ecs.Instance) error {
+	metadataOptions := r.providerSpec.MetadataOptions
+	if metadataOptions.HTTPTokens == "" && metadataOptions.HTTPEndpoint == "" && metadataOptions.HTTPPutResponseHopLimit == 0 && metadataOptions.InstanceMetadataTags == "" {
+		return nil
+	}
+
+	request := ecs.CreateModifyInstanceMetadataOptionsRequest()
+	request.Scheme = "https"
+	request.InstanceId = instance.InstanceId
+	if metadataOptions.HTTPTokens != "" {
+		request.HttpTokens = metadataOptions.HTTPTokens
+	}
+	if metadataOptions.HTTPEndpoint != "" {
+		request.HttpEndpoint = metadataOptions.HTTPEndpoint
+	}
+	if metadataOptions.HTTPPutResponseHopLimit > 0 {
+		request.HttpPutResponseHopLimit = requests.NewInteger64(metadataOptions.HTTPPutResponseHopLimit)
+	}
+	if metadataOptions.InstanceMetadataTags != "" {
+		// InstanceMetadataTags has no typed field on the vendored ModifyInstanceMetadataOptionsRequest
+		// yet; set it as a raw query parameter instead.
+		request.QueryParams["InstanceMetadataTags"] = metadataOptions.InstanceMetadataTags
+	}
+
+	if _, err := r.alibabacloudClient.ModifyInstanceMetadataOptions(ctx, request); err != nil {
+		return fmt.Errorf("failed to modify metadata options for instance %s: %w", instance.InstanceId, err)
+	}
+
+	return nil
+}
+
+// reconcileSourceDestCheck pushes the provider spec's SourceDestCheck setting to the instance's
+// primary network interface via ModifyNetworkInterfaceAttribute, so nodes acting as NAT/egress
+// gateways can forward traffic not addressed to themselves.
+func (r *Reconciler) reconcileSourceDestCheck(ctx context.Context, instance *ecs.Instance) error {
+	if r.providerSpec.SourceDestCheck == nil {
+		return nil
+	}
+
+	networkInterfaces := instance.NetworkInterfaces.NetworkInterface
+	if len(networkInterfaces) == 0 {
+		return fmt.Errorf("instance %s has no network interfaces", instance.InstanceId)
+	}
+
+	request := ecs.CreateModifyNetworkInterfaceAttributeRequest()
+	request.Scheme = "https"
+	request.NetworkInterfaceId = networkInterfaces[0].NetworkInterfaceId
+	// SourceDestCheck has no typed field on the vendored ModifyNetworkInterfaceAttributeRequest
+	// yet; set it as a raw query parameter instead.
+	request.QueryParams["SourceDestCheck"] = strconv.FormatBool(*r.providerSpec.SourceDestCheck)
+
+	if _, err := r.alibabacloudClient.ModifyNetworkInterfaceAttribute(ctx, request); err != nil {
+		return fmt.Errorf("failed to modify source/dest check for network interface %s: %w", request.NetworkInterfaceId, err)
+	}
+
+	return nil
+}
+
+// reconcileSecurityGroups converges the instance's security group membership onto the provider
+// spec's SecurityGroups when they've changed since the instance was created, calling
+// JoinSecurityGroup/LeaveSecurityGroup instead of leaving the instance on its original groups
+// until it is recreated.
+func (r *Reconciler) reconcileSecurityGroups(ctx context.Context, instance *ecs.Instance) error {
+	machineKey := runtimeclient.ObjectKey{Namespace: r.machine.Namespace, Name: r.machine.Name}
+
+	clusterID, ok := getClusterID(r.machine)
+	if !ok {
+		return fmt.Errorf("unable to get cluster ID for machine: %q", r.machine.Name)
+	}
+
+	desiredIDs, err := getSecurityGroupIDs(ctx, machineKey, clusterID, r.providerSpec, r.alibabacloudClient)
+	if err != nil {
+		return fmt.Errorf("failed to resolve desired security groups: %w", err)
+	}
+
+	currentIDs := instance.SecurityGroupIds.SecurityGroupId
+
+	for _, id := range *desiredIDs {
+		if containsString(currentIDs, id) {
+			continue
+		}
+		klog.Infof("%s: joining instance %s to security group %s", r.machine.Name, instance.InstanceId, id)
+		joinRequest := ecs.CreateJoinSecurityGroupRequest()
+		joinRequest.Scheme = "https"
+		joinRequest.InstanceId = instance.InstanceId
+		joinRequest.SecurityGroupId = id
+		if _, err := r.alibabacloudClient.JoinSecurityGroup(ctx, joinRequest); err != nil {
+			return fmt.Errorf("failed to join instance %s to security group %s: %w", instance.InstanceId, id, err)
+		}
+	}
+
+	for _, id := range currentIDs {
+		if containsString(*desiredIDs, id) {
+			continue
+		}
+		klog.Infof("%s: removing instance %s from security group %s", r.machine.Name, instance.InstanceId, id)
+		leaveRequest := ecs.CreateLeaveSecurityGroupRequest()
+		leaveRequest.Scheme = "https"
+		leaveRequest.InstanceId = instance.InstanceId
+		leaveRequest.SecurityGroupId = id
+		if _, err := r.alibabacloudClient.LeaveSecurityGroup(ctx, leaveRequest); err != nil {
+			return fmt.Errorf("failed to remove instance %s from security group %s: %w", instance.InstanceId, id, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileInstanceTypeResize performs an in-place InstanceType change when
+// allowInstanceResizeAnnotation is set, avoiding a full machine replacement for vertical resizes.
+// The instance is stopped, resized via ModifyInstanceSpec, and started back up; because each of
+// those steps is asynchronous, this is driven across repeated reconciles the same way
+// reconcilePowerState drives a stop/start rather than waiting for each step inline.
+func (r *Reconciler) reconcileInstanceTypeResize(ctx context.Context, instance *ecs.Instance) error {
+	if _, ok := r.machine.Annotations[allowInstanceResizeAnnotation]; !ok {
+		return nil
+	}
+
+	if r.providerSpec.InstanceType == "" || r.providerSpec.InstanceType == instance.InstanceType {
+		return nil
+	}
+
+	switch instance.Status {
+	case ECSInstanceStatusRunning:
+		klog.Infof("%s: stopping instance %s to resize it from %s to %s", r.machine.Name, instance.InstanceId, instance.InstanceType, r.providerSpec.InstanceType)
+		if _, err := stopInstances(ctx, r.alibabacloudClient, r.providerSpec.RegionID, r.providerSpec.StoppedMode, []*ecs.Instance{instance}); err != nil {
+			return fmt.Errorf("failed to stop instance %s for resize: %w", instance.InstanceId, err)
+		}
+	case ECSInstanceStatusStopped:
+		klog.Infof("%s: resizing instance %s from %s to %s", r.machine.Name, instance.InstanceId, instance.InstanceType, r.providerSpec.InstanceType)
+		modifyInstanceSpecRequest := ecs.CreateModifyInstanceSpecRequest()
+		modifyInstanceSpecRequest.Scheme = "https"
+		modifyInstanceSpecRequest.InstanceId = instance.InstanceId
+		modifyInstanceSpecRequest.InstanceType = r.providerSpec.InstanceType
+		if _, err := r.alibabacloudClient.ModifyInstanceSpec(ctx, modifyInstanceSpecRequest); err != nil {
+			return fmt.Errorf("failed to resize instance %s to %s: %w", instance.InstanceId, r.providerSpec.InstanceType, err)
+		}
+
+		klog.Infof("%s: starting resized instance %s", r.machine.Name, instance.InstanceId)
+		startInstanceRequest := ecs.CreateStartInstanceRequest()
+		startInstanceRequest.Scheme = "https"
+		startInstanceRequest.InstanceId = instance.InstanceId
+		if _, err := r.alibabacloudClient.StartInstance(ctx, startInstanceRequest); err != nil {
+			return fmt.Errorf("failed to start resized instance %s: %w", instance.InstanceId, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileNewDataDisks attaches any DataDisks entry that isn't yet attached to instance, so
+// growing DataDisks in the provider spec for an existing machine takes effect without recreating
+// it. DiskID entries are attached directly via AttachDisk; entries without a DiskID are new disks,
+// created with CreateDisk and then attached, and are matched across reconciles by DiskName so an
+// already-created disk is not created again. Entries without a Name cannot be safely matched back
+// to an already-created disk, so they are skipped with a warning rather than risking a duplicate
+// create on every reconcile.
+func (r *Reconciler) reconcileNewDataDisks(ctx context.Context, instance *ecs.Instance) error {
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instance.InstanceId
+
+	describeDisksResponse, err := r.alibabacloudClient.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("failed to describe disks for instance %s: %w", instance.InstanceId, err)
+	}
+
+	attachedIDs := make(map[string]bool)
+	attachedNames := make(map[string]bool)
+	if describeDisksResponse != nil {
+		for _, disk := range describeDisksResponse.Disks.Disk {
+			attachedIDs[disk.DiskId] = true
+			if disk.DiskName != "" {
+				attachedNames[disk.DiskName] = true
+			}
+		}
+	}
+
+	for _, dataDisk := range r.providerSpec.DataDisks {
+		if dataDisk.DiskID != "" {
+			if attachedIDs[dataDisk.DiskID] {
+				continue
+			}
+			klog.Infof("%s: attaching newly added disk %s to instance %s", r.machine.Name, dataDisk.DiskID, instance.InstanceId)
+			attachDiskRequest := ecs.CreateAttachDiskRequest()
+			attachDiskRequest.Scheme = "https"
+			attachDiskRequest.InstanceId = instance.InstanceId
+			attachDiskRequest.DiskId = dataDisk.DiskID
+			if _, err := r.alibabacloudClient.AttachDisk(ctx, attachDiskRequest); err != nil {
+				return fmt.Errorf("error attaching disk %s to instance %s: %w", dataDisk.DiskID, instance.InstanceId, err)
+			}
+			continue
+		}
+
+		if dataDisk.Name == "" {
+			klog.Warningf("%s: DataDisks entry without DiskID or Name cannot be tracked across reconciles, skipping", r.machine.Name)
+			continue
+		}
+
+		if attachedNames[dataDisk.Name] {
+			continue
+		}
+
+		klog.Infof("%s: creating newly added disk %s for instance %s", r.machine.Name, dataDisk.Name, instance.InstanceId)
+		createDiskRequest := ecs.CreateCreateDiskRequest()
+		createDiskRequest.Scheme = "https"
+		createDiskRequest.ZoneId = instance.ZoneId
+		createDiskRequest.DiskName = dataDisk.Name
+		createDiskRequest.Size = requests.NewInteger64(dataDisk.Size)
+		createDiskRequest.DiskCategory = string(dataDisk.Category)
+		createDiskRequest.Encrypted = requests.NewBoolean(dataDisk.DiskEncryption == machinev1.AlibabaDiskEncryptionEnabled)
+		if dataDisk.SnapshotID != "" {
+			createDiskRequest.SnapshotId = dataDisk.SnapshotID
+		}
+		if dataDisk.PerformanceLevel != "" {
+			createDiskRequest.PerformanceLevel = string(dataDisk.PerformanceLevel)
+		}
+		if dataDisk.KMSKeyID != "" {
+			createDiskRequest.KMSKeyId = dataDisk.KMSKeyID
+		}
+
+		createDiskResponse, err := r.alibabacloudClient.CreateDisk(ctx, createDiskRequest)
+		if err != nil {
+			return fmt.Errorf("error creating disk %s for instance %s: %w", dataDisk.Name, instance.InstanceId, err)
+		}
+
+		attachDiskRequest := ecs.CreateAttachDiskRequest()
+		attachDiskRequest.Scheme = "https"
+		attachDiskRequest.InstanceId = instance.InstanceId
+		attachDiskRequest.DiskId = createDiskResponse.DiskId
+		if _, err := r.alibabacloudClient.AttachDisk(ctx, attachDiskRequest); err != nil {
+			return fmt.Errorf("error attaching newly created disk %s to instance %s: %w", createDiskResponse.DiskId, instance.InstanceId, err)
+		}
+	}
+
+	return nil
+}
+
+// reconcileDiskResize detects when the system disk, or a data disk attached by DiskID, has grown
+// in the provider spec relative to its current size, and calls ResizeDisk (online) to grow it in
+// place instead of ignoring the change. Freshly-created data disks (not attached by DiskID)
+// cannot be reliably correlated back to a specific DataDisks entry without a serial/device
+// identifier the vendored SDK does not expose, so only the system disk and DiskID-referenced data
+// disks are resized here.
+func (r *Reconciler) reconcileDiskResize(ctx context.Context, instance *ecs.Instance) error {
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instance.InstanceId
+
+	describeDisksResponse, err := r.alibabacloudClient.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("failed to describe disks for instance %s: %w", instance.InstanceId, err)
+	}
+
+	if describeDisksResponse == nil {
+		return nil
+	}
+
+	for _, disk := range describeDisksResponse.Disks.Disk {
+		desiredSize := r.desiredDiskSize(disk)
+		if desiredSize <= 0 || int64(disk.Size) >= desiredSize {
+			continue
+		}
+
+		klog.Infof("%s: resizing disk %s from %dGiB to %dGiB", r.machine.Name, disk.DiskId, disk.Size, desiredSize)
+
+		resizeDiskRequest := ecs.CreateResizeDiskRequest()
+		resizeDiskRequest.Scheme = "https"
+		resizeDiskRequest.DiskId = disk.DiskId
+		resizeDiskRequest.Type = "online"
+		resizeDiskRequest.NewSize = requests.NewInteger64(desiredSize)
+
+		condition := metav1.Condition{
+			Type:    diskResizeConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  "DiskResizeRequested",
+			Message: fmt.Sprintf("requested online resize of disk %s from %dGiB to %dGiB", disk.DiskId, disk.Size, desiredSize),
+		}
+
+		if _, err := r.alibabacloudClient.ResizeDisk(ctx, resizeDiskRequest); err != nil {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "DiskResizeFailed"
+			condition.Message = fmt.Sprintf("failed to resize disk %s to %dGiB: %v", disk.DiskId, desiredSize, err)
+			_ = r.machineScope.setProviderStatus(instance, condition)
+			return fmt.Errorf("failed to resize disk %s to %dGiB: %w", disk.DiskId, desiredSize, err)
+		}
+
+		if err := r.machineScope.setProviderStatus(instance, condition); err != nil {
+			return fmt.Errorf("failed to update machine status after resizing disk %s: %w", disk.DiskId, err)
+		}
+	}
+
+	return nil
+}
+
+// desiredDiskSize returns the provider spec's requested size for disk, or 0 if disk cannot be
+// correlated to a provider spec entry.
+func (r *Reconciler) desiredDiskSize(disk ecs.Disk) int64 {
+	if disk.Type == "system" {
+		return r.providerSpec.SystemDisk.Size
+	}
+
+	for _, dataDisk := range r.providerSpec.DataDisks {
+		if dataDisk.DiskID != "" && dataDisk.DiskID == disk.DiskId {
+			return dataDisk.Size
+		}
+	}
+
+	return 0
+}
+
+// reconcileDrift diffs key fields of the provider spec against the live instance (instance type,
+// security groups, vswitch, disk sizes, tags) and publishes the result as a MachineDrifted
+// condition, so operators can tell when a machine no longer matches its spec without diffing it
+// by hand. It does not correct drift itself; of these fields, disk sizes (reconcileDiskResize),
+// security groups (reconcileSecurityGroups), instance type (reconcileInstanceTypeResize, only with
+// allowInstanceResizeAnnotation) and tags (correctExistingTags) are also actively reconciled, and
+// the vswitch is not.
+func (r *Reconciler) reconcileDrift(ctx context.Context, instance *ecs.Instance) error {
+	var drifted []string
+
+	if r.providerSpec.InstanceType != "" && r.providerSpec.InstanceType != instance.InstanceType {
+		drifted = append(drifted, fmt.Sprintf("instance type: spec %q, instance %q", r.providerSpec.InstanceType, instance.InstanceType))
+	}
+
+	if r.providerSpec.VSwitch.ID != "" && r.providerSpec.VSwitch.ID != instance.VpcAttributes.VSwitchId {
+		drifted = append(drifted, fmt.Sprintf("vswitch: spec %q, instance %q", r.providerSpec.VSwitch.ID, instance.VpcAttributes.VSwitchId))
+	}
+
+	for _, securityGroup := range r.providerSpec.SecurityGroups {
+		if securityGroup.ID == "" {
+			continue
+		}
+		if !containsString(instance.SecurityGroupIds.SecurityGroupId, securityGroup.ID) {
+			drifted = append(drifted, fmt.Sprintf("security group %s in spec is not attached to instance", securityGroup.ID))
+		}
+	}
+
+	for _, tag := range r.providerSpec.Tags {
+		if !instanceHasTag(instance, tag.Key, tag.Value) {
+			drifted = append(drifted, fmt.Sprintf("tag %s: spec %q, not present on instance with that value", tag.Key, tag.Value))
+		}
+	}
+
+	describeDisksRequest := ecs.CreateDescribeDisksRequest()
+	describeDisksRequest.Scheme = "https"
+	describeDisksRequest.InstanceId = instance.InstanceId
+
+	describeDisksResponse, err := r.alibabacloudClient.DescribeDisks(ctx, describeDisksRequest)
+	if err != nil {
+		return fmt.Errorf("failed to describe disks for instance %s: %w", instance.InstanceId, err)
+	}
+
+	if describeDisksResponse != nil {
+		for _, disk := range describeDisksResponse.Disks.Disk {
+			if desiredSize := r.desiredDiskSize(disk); desiredSize > 0 && desiredSize != int64(disk.Size) {
+				drifted = append(drifted, fmt.Sprintf("disk %s size: spec %dGiB, instance %dGiB", disk.DiskId, desiredSize, disk.Size))
+			}
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:   driftConditionType,
+		Status: metav1.ConditionFalse,
+		Reason: "NoDrift",
+	}
+
+	if len(drifted) > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "DriftDetected"
+		condition.Message = strings.Join(drifted, "; ")
+		klog.Infof("%s: drift detected from provider spec: %s", r.machine.Name, condition.Message)
+	}
+
+	if err := r.machineScope.setProviderStatus(instance, condition); err != nil {
+		return fmt.Errorf("failed to set drift condition: %w", err)
+	}
+
+	return nil
+}
+
+// containsString reports whether s is present in list.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceHasTag reports whether instance carries an ECS tag with the given key and value.
+func instanceHasTag(instance *ecs.Instance, key, value string) bool {
+	for _, tag := range instance.Tags.Tag {
+		if tag.TagKey == key && tag.TagValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) requeueIfInstancePending(ctx context.Context, instance *ecs.Instance) error {
 	// If machine state is still pending, we will return an error to keep the controllers
 	// attempting to update status until it hits a more permanent state. This will ensure
 	// we get a public IP populated more quickly.
 	if instance.Status == ECSInstanceStatusPending {
+		if readyTimeout := instanceReadyTimeout(r.providerSpec); time.Since(r.machine.CreationTimestamp.Time) > readyTimeout {
+			return machinecontroller.CreateMachine("instance %s did not become ready within %s", instance.InstanceId, readyTimeout)
+		}
+
 		klog.Infof("%s: Instance state still pending, returning an error to requeue", r.machine.Name)
+		if r.eventRecorder != nil {
+			r.eventRecorder.Eventf(r.machine, corev1.EventTypeNormal, "WaitingForRunning", "instance %s is still pending", instance.InstanceId)
+		}
+		return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
+	}
+
+	// Stopping/Starting are transitional power-state changes with no timeout of their own:
+	// requeue and re-check once the instance settles into Running or Stopped rather than
+	// treating the transition itself as an error.
+	if instance.Status == ECSInstanceStatusStopping || instance.Status == ECSInstanceStatusStarting {
+		klog.Infof("%s: instance %s is %s, requeuing until the transition completes", r.machine.Name, instance.InstanceId, instance.Status)
 		return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
 	}
 
 	return nil
 }
 
+// instanceReadyTimeout returns how long a Machine may sit in the Pending state before
+// requeueIfInstancePending gives up, defaulting to InstanceDefaultTimeout when
+// InstanceReadyTimeout is unset.
+func instanceReadyTimeout(providerSpec *machinev1.AlibabaCloudMachineProviderConfig) time.Duration {
+	if providerSpec.InstanceReadyTimeout != nil {
+		return time.Duration(*providerSpec.InstanceReadyTimeout) * time.Second
+	}
+
+	return InstanceDefaultTimeout * time.Second
+}
+
+// instanceDeleteTimeout returns how long DeleteMachine waits, after its Force DeleteInstances call,
+// for the instances to disappear, defaulting to InstanceDefaultTimeout when InstanceDeleteTimeout
+// is unset.
+func instanceDeleteTimeout(providerSpec *machinev1.AlibabaCloudMachineProviderConfig) int {
+	if providerSpec.InstanceDeleteTimeout != nil {
+		return int(*providerSpec.InstanceDeleteTimeout)
+	}
+
+	return InstanceDefaultTimeout
+}
+
 // Delete deletes machine
 func (r *Reconciler) Delete(ctx context.Context) error {
-	klog.Infof("%s: deleting machine", r.machine.Name)
+	r.log.Info("deleting machine")
 
 	if err := r.DeleteMachine(ctx); err != nil {
 		return err
 	}
 
-	klog.Infof("Deleted machine %v", r.machine.Name)
+	r.log.Info("deleted machine")
 	return nil
 }
 
 func (r *Reconciler) DeleteMachine(ctx context.Context) error {
 	// Get all instances not terminated.
-	existingInstances, err := r.getMachineInstances()
+	existingInstances, err := r.getMachineInstances(ctx)
 	if err != nil {
 		metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
 			Name:      r.machine.Name,
 			Namespace: r.machine.Namespace,
 			Reason:    err.Error(),
 		})
-		klog.Errorf("%s: error getting existing instances: %v", r.machine.Name, err)
+		r.log.Error(err, "error getting existing instances")
 		return err
 	}
 
 	existingLen := len(existingInstances)
-	klog.Infof("%s: found %d existing instances for machine", r.machine.Name, existingLen)
+	r.log.Info("found existing instances for machine", "count", existingLen)
 	if existingLen < 1 {
-		klog.Warningf("%s: no instances found to delete for machine", r.machine.Name)
+		r.log.Info("no instances found to delete for machine")
 		return nil
 	}
 
-	// stopInstances stop all running instances ,if instance stauts not running ,skip stop it
-	stoppedInstances, err := stopInstances(r.alibabacloudClient, r.providerSpec.RegionID, existingInstances)
-	if err != nil {
-		metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
-			Name:      r.machine.Name,
-			Namespace: r.machine.Namespace,
-			Reason:    err.Error(),
-		})
-		klog.Errorf("failed to stop instances %v error %v", existingInstances, err)
-		return fmt.Errorf("failed to stop instaces: %w", err)
+	if _, ok := r.machine.Annotations[skipInstanceDeletionAnnotation]; ok {
+		clusterID, ok := getClusterID(r.machine)
+		if !ok {
+			return fmt.Errorf("unable to get cluster ID for machine: %q", r.machine.Name)
+		}
+		for _, instance := range existingInstances {
+			if err := untagInstanceOwnership(ctx, clusterID, r.providerSpec.RegionID, instance.InstanceId, r.alibabacloudClient); err != nil {
+				metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
+					Name:      r.machine.Name,
+					Namespace: r.machine.Namespace,
+					Reason:    err.Error(),
+				})
+				klog.Errorf("%s: failed to untag instance %s: %v", r.machine.Name, instance.InstanceId, err)
+				return fmt.Errorf("failed to untag instance: %w", err)
+			}
+		}
+		klog.Infof("%s: %s set, skipping instance deletion and only removing ownership tags", r.machine.Name, skipInstanceDeletionAnnotation)
+		return nil
 	}
 
-	if len(stoppedInstances) == 1 {
-		if stoppedInstances[0].Code == "200" && stoppedInstances[0].CurrentStatus != "" {
-			r.machine.Annotations[machinecontroller.MachineInstanceStateAnnotationName] = stoppedInstances[0].CurrentStatus
+	for _, instance := range existingInstances {
+		if instance.Status == ECSInstanceStatusStopping || instance.Status == ECSInstanceStatusStarting {
+			klog.Infof("%s: instance %s is %s, requeuing delete until the transition completes", r.machine.Name, instance.InstanceId, instance.Status)
+			return &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
 		}
 	}
 
-	existingInstancesIds := make([]string, 0)
-	for _, instance := range existingInstances {
-		existingInstancesIds = append(existingInstancesIds, instance.InstanceId)
+	if r.providerStatus.EIPAllocationID != nil && *r.providerStatus.EIPAllocationID != "" {
+		if err := releaseEIP(ctx, *r.providerStatus.EIPAllocationID, existingInstances[0].InstanceId, r.alibabacloudClient); err != nil {
+			metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
+				Name:      r.machine.Name,
+				Namespace: r.machine.Namespace,
+				Reason:    err.Error(),
+			})
+			klog.Errorf("%s: failed to release EIP %s: %v", r.machine.Name, *r.providerStatus.EIPAllocationID, err)
+			return fmt.Errorf("failed to release EIP: %w", err)
+		}
 	}
 
-	// wait for all instances stopped
-	// Query the status of the instance until Stopped
-	_, err = waitForInstancesStatus(r.alibabacloudClient, r.providerSpec.RegionID, existingInstancesIds, ECSInstanceStatusStopped, InstanceDefaultTimeout)
-	if err != nil {
+	if r.providerSpec.DeletionProtection {
+		if err := clearDeletionProtection(ctx, existingInstances, r.alibabacloudClient); err != nil {
+			metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
+				Name:      r.machine.Name,
+				Namespace: r.machine.Namespace,
+				Reason:    err.Error(),
+			})
+			klog.Errorf("%s: failed to clear deletion protection: %v", r.machine.Name, err)
+			return fmt.Errorf("failed to clear deletion protection: %w", err)
+		}
+	}
+
+	if err := detachExistingDataDisks(ctx, r.providerSpec, existingInstances[0].InstanceId, r.alibabacloudClient); err != nil {
 		metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
 			Name:      r.machine.Name,
 			Namespace: r.machine.Namespace,
 			Reason:    err.Error(),
 		})
-		klog.Errorf("failed to wait for  instances %v stopped: %v", existingInstancesIds, err)
-		return fmt.Errorf("failed to wait for  instances stopped: %v", err)
+		klog.Errorf("%s: failed to detach existing data disks: %v", r.machine.Name, err)
+		return fmt.Errorf("failed to detach existing data disks: %w", err)
+	}
+
+	existingInstancesIds := make([]string, 0)
+	for _, instance := range existingInstances {
+		existingInstancesIds = append(existingInstancesIds, instance.InstanceId)
 	}
 
-	// delete stoppted instances
+	// delete instances, forcing Running instances to terminate directly instead of stopping them
+	// first, so a Stopped instance can never be left stranded if the delete step fails partway.
 	for _, instanceID := range existingInstancesIds {
 		klog.Infof("delete %v instance", instanceID)
 	}
@@ -257,8 +1088,16 @@ func (r *Reconciler) DeleteMachine(ctx context.Context) error {
 	deleteInstancesRequest.Scheme = "https"
 	deleteInstancesRequest.RegionId = r.providerSpec.RegionID
 	deleteInstancesRequest.InstanceId = &existingInstancesIds
+	deleteInstancesRequest.Force = requests.NewBoolean(true)
+
+	// Subscription (PrePaid) instances are not deleted by default before they expire: ask ECS to
+	// terminate the subscription early (with a partial refund) so machine-api initiated deletions
+	// still succeed instead of silently failing.
+	if r.providerSpec.Subscription != nil {
+		deleteInstancesRequest.TerminateSubscription = requests.NewBoolean(true)
+	}
 
-	deleteInstancsResponse, err := r.alibabacloudClient.DeleteInstances(deleteInstancesRequest)
+	deleteInstancsResponse, err := r.alibabacloudClient.DeleteInstances(ctx, deleteInstancesRequest)
 	if err != nil {
 		metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
 			Name:      r.machine.Name,
@@ -270,13 +1109,24 @@ func (r *Reconciler) DeleteMachine(ctx context.Context) error {
 	}
 
 	klog.V(3).Infof("Delete instance response %v", deleteInstancsResponse)
+
+	if err := waitForInstancesDeleted(ctx, r.alibabacloudClient, r.providerSpec.RegionID, existingInstancesIds, instanceDeleteTimeout(r.providerSpec)); err != nil {
+		metrics.RegisterFailedInstanceDelete(&metrics.MachineLabels{
+			Name:      r.machine.Name,
+			Namespace: r.machine.Namespace,
+			Reason:    err.Error(),
+		})
+		klog.Errorf("failed to wait for instances %v to be deleted: %v", existingInstancesIds, err)
+		return fmt.Errorf("failed to wait for instances to be deleted: %w", err)
+	}
+
 	return nil
 }
 
 // Exists checks if machine exists
 func (r *Reconciler) Exists(ctx context.Context) (bool, error) {
 	// Get all instances not terminated.
-	existingInstances, err := r.getMachineInstances()
+	existingInstances, err := r.getMachineInstances(ctx)
 	if err != nil {
 		// Reporting as update here, as successfull return value from the method
 		// later indicases that an instance update flow will be executed.
@@ -285,23 +1135,44 @@ func (r *Reconciler) Exists(ctx context.Context) (bool, error) {
 			Namespace: r.machine.Namespace,
 			Reason:    err.Error(),
 		})
-		klog.Errorf("%s: error getting existing instances: %v", r.machine.Name, err)
+		r.log.Error(err, "error getting existing instances")
 		return false, err
 	}
 
 	if len(existingInstances) == 0 {
 		if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && (r.machine.Status.LastUpdated == nil || r.machine.Status.LastUpdated.Add(requeueAfterSeconds*time.Second).After(time.Now())) {
-			klog.Infof("%s: Possible eventual-consistency discrepancy; returning an error to requeue", r.machine.Name)
+			r.log.Info("possible eventual-consistency discrepancy, returning an error to requeue")
 			return false, &machinecontroller.RequeueAfterError{RequeueAfter: requeueAfterSeconds * time.Second}
 		}
 
-		klog.Infof("%s: Instance does not exist", r.machine.Name)
+		r.log.Info("instance does not exist")
 		return false, nil
 	}
 
 	return existingInstances[0] != nil, err
 }
 
+// recordProvisioningDuration observes instanceProvisioningDuration the first time instance is
+// seen as Running, comparing against previousState (providerStatus.InstanceState as recorded on
+// the previous reconcile, captured by the caller before setProviderStatus updates it) so the
+// metric is recorded exactly once per instance rather than on every subsequent reconcile.
+func (r *Reconciler) recordProvisioningDuration(instance *ecs.Instance, previousState *string) {
+	if instance == nil || instance.Status != ECSInstanceStatusRunning {
+		return
+	}
+	if previousState != nil && *previousState == ECSInstanceStatusRunning {
+		return
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, instance.CreationTime)
+	if err != nil {
+		klog.Warningf("%s: failed to parse instance creation time %q: %v", r.machine.Name, instance.CreationTime, err)
+		return
+	}
+
+	instanceProvisioningDuration.WithLabelValues(instance.InstanceType, instance.ZoneId).Observe(time.Since(createdAt).Seconds())
+}
+
 // setProviderID adds providerID in the machine spec
 func (r *Reconciler) setProviderID(instance *ecs.Instance) error {
 	existingProviderID := r.machine.Spec.ProviderID
@@ -320,7 +1191,35 @@ func (r *Reconciler) setProviderID(instance *ecs.Instance) error {
 	return nil
 }
 
-func (r *Reconciler) setMachineCloudProviderSpecifics(instance *ecs.Instance) error {
+// reconcileProviderID surfaces a mismatch between machine.Spec.ProviderID and instance, the
+// instance this actuator found for the machine via its tags, as a ProviderIDValid condition.
+// It does not correct the mismatch itself: setProviderID already rewrites machine.Spec.ProviderID
+// to match instance on every create and update reconcile, so by the time this condition is next
+// observed the providerID has typically already self-healed.
+func (r *Reconciler) reconcileProviderID(instance *ecs.Instance) error {
+	expectedProviderID := fmt.Sprintf("alicloud://%s.%s", instance.RegionId, instance.InstanceId)
+
+	condition := metav1.Condition{
+		Type:   providerIDConditionType,
+		Status: metav1.ConditionTrue,
+		Reason: "ProviderIDMatchesInstance",
+	}
+
+	if r.machine.Spec.ProviderID != nil && *r.machine.Spec.ProviderID != "" && *r.machine.Spec.ProviderID != expectedProviderID {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "ProviderIDStale"
+		condition.Message = fmt.Sprintf("machine providerID %q does not match instance %s found via tags, expected %q", *r.machine.Spec.ProviderID, instance.InstanceId, expectedProviderID)
+		klog.Warningf("%s: %s", r.machine.Name, condition.Message)
+	}
+
+	if err := r.machineScope.setProviderStatus(instance, condition); err != nil {
+		return fmt.Errorf("failed to set providerID condition: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) setMachineCloudProviderSpecifics(ctx context.Context, instance *ecs.Instance) error {
 	if instance == nil {
 		return nil
 	}
@@ -349,19 +1248,64 @@ func (r *Reconciler) setMachineCloudProviderSpecifics(instance *ecs.Instance) er
 		r.machine.Annotations[machinecontroller.MachineInstanceStateAnnotationName] = instance.Status
 	}
 
+	r.setGPUTopologyLabels(ctx, instance)
+	r.setLocalStorageLabel(ctx, instance)
+
 	return nil
 }
 
-func (r *Reconciler) getMachineInstances() ([]*ecs.Instance, error) {
+// setLocalStorageLabel labels the machine when its instance type has local (instance store)
+// disks, and warns that their data is ephemeral, since local disks are wiped on stop/start and
+// lost on instance failure unlike cloud disks. A failure to describe the instance type is logged
+// but does not fail the reconcile, since this labeling is best-effort metadata rather than
+// something the machine's existence depends on.
+func (r *Reconciler) setLocalStorageLabel(ctx context.Context, instance *ecs.Instance) {
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, r.alibabacloudClient, r.providerSpec.RegionID, instance.InstanceType)
+	if err != nil {
+		klog.Warningf("%s: failed to describe instance type %s for local storage label: %v", r.machine.Name, instance.InstanceType, err)
+		return
+	}
+
+	if it.LocalStorageAmount <= 0 {
+		return
+	}
+
+	r.machine.Labels[localStorageLabel] = "true"
+	klog.Warningf("%s: instance type %s has %d local disk(s) of category %s; data on local disks does not survive a stop/start or instance failure",
+		r.machine.Name, instance.InstanceType, it.LocalStorageAmount, it.LocalStorageCategory)
+}
+
+// setGPUTopologyLabels labels the machine with its GPU count and accelerator model when the
+// instance type provides GPUs, so pods can request a specific accelerator via nodeSelector.
+// A failure to describe the instance type is logged but does not fail the reconcile, since GPU
+// labeling is best-effort metadata rather than something the machine's existence depends on.
+func (r *Reconciler) setGPUTopologyLabels(ctx context.Context, instance *ecs.Instance) {
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, r.alibabacloudClient, r.providerSpec.RegionID, instance.InstanceType)
+	if err != nil {
+		klog.Warningf("%s: failed to describe instance type %s for GPU labels: %v", r.machine.Name, instance.InstanceType, err)
+		return
+	}
+
+	if it.GPUAmount <= 0 {
+		return
+	}
+
+	r.machine.Labels[gpuCountLabel] = strconv.Itoa(it.GPUAmount)
+	if it.GPUSpec != "" {
+		r.machine.Labels[acceleratorTypeLabel] = it.GPUSpec
+	}
+}
+
+func (r *Reconciler) getMachineInstances(ctx context.Context) ([]*ecs.Instance, error) {
 	if r.providerStatus.InstanceID != nil && *r.providerStatus.InstanceID != "" {
-		i, err := getExistingInstanceByID(*r.providerStatus.InstanceID, r.providerSpec.RegionID, r.alibabacloudClient)
+		i, err := getExistingInstanceByID(ctx, *r.providerStatus.InstanceID, r.providerSpec.RegionID, r.alibabacloudClient, r.providerSpec)
 		if err != nil {
-			klog.Warningf("%s: Failed to find existing instance by id %s: %v", r.machine.Name, *r.providerStatus.InstanceID, err)
+			r.log.Error(err, "failed to find existing instance by id", "instanceID", *r.providerStatus.InstanceID)
 		} else {
-			klog.Infof("%s: Found instance by id: %s", r.machine.Name, *r.providerStatus.InstanceID)
+			r.log.Info("found instance by id", "instanceID", *r.providerStatus.InstanceID)
 			return []*ecs.Instance{i}, nil
 		}
 	}
 
-	return getExistingInstances(r.machine, r.providerSpec.RegionID, r.alibabacloudClient)
+	return getExistingInstances(ctx, r.machine, r.providerSpec.RegionID, r.alibabacloudClient, r.providerSpec)
 }
@@ -4,24 +4,62 @@ import (
 	"fmt"
 	"time"
 
+	providerv1 "github.com/openshift/api/machine/v1"
 	machinev1 "github.com/openshift/api/machine/v1beta1"
 	machinecontroller "github.com/openshift/machine-api-operator/pkg/controller/machine"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/klog"
+	"k8s.io/klog/v2"
 )
 
+// machineLogger carries the structured keysAndValues identifying a machine (name, namespace and,
+// once known, instanceID), so the Reconciler's klog/v2 structured log lines for that machine can
+// be correlated without repeating the machine's identity at each call site.
+type machineLogger struct {
+	keysAndValues []interface{}
+}
+
+// newMachineLogger returns a machineLogger tagged with machine's name and namespace.
+func newMachineLogger(machine *machinev1.Machine) *machineLogger {
+	return &machineLogger{keysAndValues: []interface{}{"machine", machine.Name, "namespace", machine.Namespace}}
+}
+
+// withInstanceID returns a copy of l additionally tagged with instanceID, for use once the
+// instance backing the machine is known.
+func (l *machineLogger) withInstanceID(instanceID string) *machineLogger {
+	tagged := append(append([]interface{}{}, l.keysAndValues...), "instanceID", instanceID)
+	return &machineLogger{keysAndValues: tagged}
+}
+
+// Info logs msg at the default verbosity with l's machine context plus any extra keysAndValues.
+func (l *machineLogger) Info(msg string, keysAndValues ...interface{}) {
+	klog.InfoS(msg, append(append([]interface{}{}, l.keysAndValues...), keysAndValues...)...)
+}
+
+// Error logs msg and err with l's machine context plus any extra keysAndValues.
+func (l *machineLogger) Error(err error, msg string, keysAndValues ...interface{}) {
+	klog.ErrorS(err, msg, append(append([]interface{}{}, l.keysAndValues...), keysAndValues...)...)
+}
+
 // upstreamMachineClusterIDLabel is the label that a machine must have to identify the cluster to which it belongs
 const upstreamMachineClusterIDLabel = "sigs.k8s.io/cluster-api-cluster"
 
-// supportedInstanceStates returns the list of states an ECS instance
-func supportedInstanceStates() []string {
-	return []string{
+// supportedInstanceStates returns the list of ECS instance states that count as an existing
+// instance for the machine. Stopped is included unless providerSpec.StoppedInstanceExists is set
+// to false, since some workflows intentionally stop worker instances without wanting the machine
+// replaced; the default preserves the historical behavior of treating Stopped as existing.
+func supportedInstanceStates(providerSpec *providerv1.AlibabaCloudMachineProviderConfig) []string {
+	states := []string{
 		ECSInstanceStatusPending,
 		ECSInstanceStatusStarting,
 		ECSInstanceStatusRunning,
 		ECSInstanceStatusStopping,
-		ECSInstanceStatusStopped,
 	}
+
+	if providerSpec == nil || providerSpec.StoppedInstanceExists == nil || *providerSpec.StoppedInstanceExists {
+		states = append(states, ECSInstanceStatusStopped)
+	}
+
+	return states
 }
 
 // validateMachine check the label that a machine must have to identify the cluster to which it belongs is present.
@@ -30,10 +30,13 @@ import (
 	mapierrors "github.com/openshift/machine-api-operator/pkg/controller/machine"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 const (
@@ -58,6 +61,7 @@ type Reconciler struct {
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
 	_, err := ctrl.NewControllerManagedBy(mgr).
 		For(&machinev1beta1.MachineSet{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.machineSetsForCredentialsSecret)).
 		WithOptions(options).
 		Build(r)
 
@@ -70,6 +74,31 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 	return nil
 }
 
+// machineSetsForCredentialsSecret requeues every MachineSet in secretObj's namespace whose
+// providerSpec references it as CredentialsSecret, so a credential rotation takes effect on the
+// next reconcile instead of waiting for the controller's periodic resync.
+func (r *Reconciler) machineSetsForCredentialsSecret(secretObj client.Object) []reconcile.Request {
+	machineSets := &machinev1beta1.MachineSetList{}
+	if err := r.Client.List(context.Background(), machineSets, client.InNamespace(secretObj.GetNamespace())); err != nil {
+		klog.Errorf("Failed to list machineSets for credentials secret %s/%s: %v", secretObj.GetNamespace(), secretObj.GetName(), err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, machineSet := range machineSets.Items {
+		providerConfig, err := alibabacloudproviderv1.ProviderSpecFromRawExtension(machineSet.Spec.Template.Spec.ProviderSpec.Value)
+		if err != nil {
+			continue
+		}
+		if providerConfig.CredentialsSecret != nil && providerConfig.CredentialsSecret.Name == secretObj.GetName() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: machineSet.Namespace, Name: machineSet.Name},
+			})
+		}
+	}
+	return requests
+}
+
 // Reconcile implements controller runtime Reconciler interface.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	logger := r.Log.WithValues("machineset", request.Name, "namespace", request.Namespace)
@@ -93,7 +122,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 	originalMachineSetToPatch := client.MergeFrom(machineSet.DeepCopy())
 
-	result, err := r.reconcile(machineSet)
+	result, err := r.reconcile(ctx, machineSet)
 	if err != nil {
 		logger.Error(err, "Failed to reconcile MachineSet")
 		r.recorder.Eventf(machineSet, corev1.EventTypeWarning, "ReconcileError", "%v", err)
@@ -113,13 +142,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return result, err
 }
 
-func (r *Reconciler) reconcile(machineSet *machinev1beta1.MachineSet) (ctrl.Result, error) {
+func (r *Reconciler) reconcile(ctx context.Context, machineSet *machinev1beta1.MachineSet) (ctrl.Result, error) {
 	providerConfig, err := alibabacloudproviderv1.ProviderSpecFromRawExtension(machineSet.Spec.Template.Spec.ProviderSpec.Value)
 	if err != nil {
 		return ctrl.Result{}, mapierrors.InvalidMachineConfiguration("failed to get providerConfig: %v", err)
 	}
 
-	instanceType, err := r.getInstanceType(machineSet, providerConfig)
+	instanceType, err := r.getInstanceType(ctx, machineSet, providerConfig)
 	if err != nil {
 		klog.Errorf("Unable to set scale from zero annotations: unknown instance type: %s", providerConfig.InstanceType)
 		klog.Errorf("Autoscaling from zero will not work. To fix this, manually populate machine annotations for your instance type: %v", []string{cpuKey, memoryKey, gpuKey})
@@ -14,17 +14,23 @@ limitations under the License.
 package machineset
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	configv1 "github.com/openshift/api/config/v1"
 	machinev1 "github.com/openshift/api/machine/v1"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 
 	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
 
 	"k8s.io/klog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// infrastructureResourceName is the name of the cluster-scoped Infrastructure object that carries
+// the region a machineset's providerSpec can default RegionID from.
+const infrastructureResourceName = "cluster"
+
 type instanceType struct {
 	InstanceType string
 	VCPU         int64
@@ -32,8 +38,37 @@ type instanceType struct {
 	GPU          int64
 }
 
+// defaultRegionFromInfrastructure reads the region operators set on the cluster-scoped
+// Infrastructure object's AlibabaCloud platform status, so a machineset's providerSpec can leave
+// RegionID empty instead of repeating it in every template. It returns an empty string, rather
+// than an error, when the platform status has no AlibabaCloud section, leaving RegionID
+// validation to the caller.
+func defaultRegionFromInfrastructure(ctx context.Context, k8sClient client.Client) (string, error) {
+	infra := &configv1.Infrastructure{}
+	if err := k8sClient.Get(ctx, client.ObjectKey{Name: infrastructureResourceName}, infra); err != nil {
+		return "", fmt.Errorf("failed to get infrastructure object: %w", err)
+	}
+
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return "", nil
+	}
+
+	return infra.Status.PlatformStatus.AlibabaCloud.Region, nil
+}
+
 // Check whether instanceType is correct, and return the corresponding CPU, MEM, and GPU data
-func (r *Reconciler) getInstanceType(machineSet *machinev1beta1.MachineSet, providerSpec *machinev1.AlibabaCloudMachineProviderConfig) (*instanceType, error) {
+func (r *Reconciler) getInstanceType(ctx context.Context, machineSet *machinev1beta1.MachineSet, providerSpec *machinev1.AlibabaCloudMachineProviderConfig) (*instanceType, error) {
+	if providerSpec.RegionID == "" {
+		region, err := defaultRegionFromInfrastructure(ctx, r.Client)
+		if err != nil {
+			return nil, err
+		}
+		if region == "" {
+			return nil, fmt.Errorf("regionID not set and cluster infrastructure has no AlibabaCloud platform status to default it from")
+		}
+		providerSpec.RegionID = region
+	}
+
 	credentialsSecretName := ""
 	if providerSpec.CredentialsSecret != nil {
 		credentialsSecretName = providerSpec.CredentialsSecret.Name
@@ -45,25 +80,17 @@ func (r *Reconciler) getInstanceType(machineSet *machinev1beta1.MachineSet, prov
 		return nil, err
 	}
 
-	instanceTypes := []string{providerSpec.InstanceType}
-	describeInstanceTypesRequest := ecs.CreateDescribeInstanceTypesRequest()
-	describeInstanceTypesRequest.RegionId = providerSpec.RegionID
-	describeInstanceTypesRequest.Scheme = "https"
-	describeInstanceTypesRequest.InstanceTypes = &instanceTypes
+	if err := alibabacloudClient.ValidateCredentials(ctx, aliClient, providerSpec.RegionID); err != nil {
+		klog.Errorf("Credentials preflight check failed: %v", err)
+		return nil, err
+	}
 
-	response, err := aliClient.DescribeInstanceTypes(describeInstanceTypesRequest)
+	it, err := alibabacloudClient.DefaultInstanceTypeCache.Get(ctx, aliClient, providerSpec.RegionID, providerSpec.InstanceType)
 	if err != nil {
 		klog.Errorf("Failed to describeInstanceTypes: %v", err)
 		return nil, err
 	}
 
-	if len(response.InstanceTypes.InstanceType) <= 0 {
-		klog.Errorf("%s no instanceType for given filters not found", providerSpec.InstanceType)
-		return nil, fmt.Errorf("%s no instanceType for given filters not found ", providerSpec.InstanceType)
-	}
-
-	it := response.InstanceTypes.InstanceType[0]
-
 	return &instanceType{
 		InstanceType: it.InstanceType,
 		VCPU:         int64(it.CpuCoreCount),
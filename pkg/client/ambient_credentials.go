@@ -0,0 +1,39 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import "sync"
+
+var (
+	ambientRAMRoleNameMutex sync.RWMutex
+	ambientRAMRoleName      string
+)
+
+// SetAmbientRAMRoleName configures the RAM role NewClient falls back to, via the ECS instance
+// metadata service, when a machine's providerSpec has no CredentialsSecret. It must be called, if
+// at all, before any AlibabaCloudClientBuilderFunc is invoked, since it has no effect on clients
+// that already exist.
+func SetAmbientRAMRoleName(roleName string) {
+	ambientRAMRoleNameMutex.Lock()
+	defer ambientRAMRoleNameMutex.Unlock()
+	ambientRAMRoleName = roleName
+}
+
+// getAmbientRAMRoleName returns the RAM role name NewClient should fall back to when no
+// credentials secret is given, or "" if ambient credentials aren't configured.
+func getAmbientRAMRoleName() string {
+	ambientRAMRoleNameMutex.RLock()
+	defer ambientRAMRoleNameMutex.RUnlock()
+	return ambientRAMRoleName
+}
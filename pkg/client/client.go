@@ -4,18 +4,25 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"math/rand"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
 
 	"k8s.io/klog/v2"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials/provider"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	clienterrors "github.com/openshift/cluster-api-provider-alibaba/pkg/client/errors"
 	"github.com/openshift/cluster-api-provider-alibaba/pkg/version"
 	machineapiapierrors "github.com/openshift/machine-api-operator/pkg/controller/machine"
 	corev1 "k8s.io/api/core/v1"
@@ -52,538 +59,1536 @@ const (
 // Client is a wrapper object for actual alibabacloud SDK clients to allow for easier testing.
 type Client interface {
 	//Ecs
-	RunInstances(*ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error)
-	CreateInstance(*ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error)
-	DescribeInstances(*ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error)
-	DeleteInstances(*ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error)
-	StartInstance(*ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error)
-	RebootInstance(request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error)
-	StopInstance(*ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error)
-	StartInstances(*ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error)
-	RebootInstances(request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error)
-	StopInstances(*ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error)
-	DeleteInstance(*ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error)
-	AttachInstanceRAMRole(*ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error)
-	DetachInstanceRAMRole(*ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error)
-	DescribeInstanceStatus(*ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error)
-	ReActivateInstances(*ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error)
-	DescribeUserData(*ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error)
-	DescribeInstanceTypes(*ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error)
-	ModifyInstanceAttribute(*ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error)
-	ModifyInstanceMetadataOptions(*ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error)
-
-	TagResources(*ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error)
-	ListTagResources(*ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error)
-	UntagResources(*ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error)
+	RunInstances(ctx context.Context, request *ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error)
+	CreateInstance(ctx context.Context, request *ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error)
+	DescribeInstances(ctx context.Context, request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error)
+	DeleteInstances(ctx context.Context, request *ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error)
+	StartInstance(ctx context.Context, request *ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error)
+	RebootInstance(ctx context.Context, request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error)
+	StopInstance(ctx context.Context, request *ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error)
+	StartInstances(ctx context.Context, request *ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error)
+	RebootInstances(ctx context.Context, request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error)
+	StopInstances(ctx context.Context, request *ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error)
+	DeleteInstance(ctx context.Context, request *ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error)
+	AttachInstanceRAMRole(ctx context.Context, request *ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error)
+	DetachInstanceRAMRole(ctx context.Context, request *ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error)
+	DescribeInstanceStatus(ctx context.Context, request *ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error)
+	DescribeInstancesFullStatus(ctx context.Context, request *ecs.DescribeInstancesFullStatusRequest) (*ecs.DescribeInstancesFullStatusResponse, error)
+	RedeployInstance(ctx context.Context, request *ecs.RedeployInstanceRequest) (*ecs.RedeployInstanceResponse, error)
+	ReActivateInstances(ctx context.Context, request *ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error)
+	DescribeUserData(ctx context.Context, request *ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error)
+	DescribeInstanceTypes(ctx context.Context, request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error)
+	DescribeAvailableResource(ctx context.Context, request *ecs.DescribeAvailableResourceRequest) (*ecs.DescribeAvailableResourceResponse, error)
+	ModifyInstanceAttribute(ctx context.Context, request *ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error)
+	ModifyInstanceMetadataOptions(ctx context.Context, request *ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error)
+	ModifyInstanceSpec(ctx context.Context, request *ecs.ModifyInstanceSpecRequest) (*ecs.ModifyInstanceSpecResponse, error)
+	GetInstanceConsoleOutput(ctx context.Context, request *ecs.GetInstanceConsoleOutputRequest) (*ecs.GetInstanceConsoleOutputResponse, error)
+	GetInstanceScreenshot(ctx context.Context, request *ecs.GetInstanceScreenshotRequest) (*ecs.GetInstanceScreenshotResponse, error)
+
+	TagResources(ctx context.Context, request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error)
+	ListTagResources(ctx context.Context, request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error)
+	UntagResources(ctx context.Context, request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error)
 
 	//Network
-	AllocatePublicIPAddress(*ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error)
+	AllocatePublicIPAddress(ctx context.Context, request *ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error)
+	DescribeNetworkInterfaces(ctx context.Context, request *ecs.DescribeNetworkInterfacesRequest) (*ecs.DescribeNetworkInterfacesResponse, error)
+	ModifyNetworkInterfaceAttribute(ctx context.Context, request *ecs.ModifyNetworkInterfaceAttributeRequest) (*ecs.ModifyNetworkInterfaceAttributeResponse, error)
 
 	//Disk
-	CreateDisk(*ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error)
-	AttachDisk(*ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error)
-	DescribeDisks(*ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error)
-	ModifyDiskChargeType(*ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error)
-	ModifyDiskAttribute(*ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error)
-	ModifyDiskSpec(*ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error)
-	ReplaceSystemDisk(*ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error)
-	ReInitDisk(*ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error)
-	ResetDisk(*ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error)
-	ResizeDisk(*ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error)
-	DetachDisk(*ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error)
-	DeleteDisk(*ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error)
+	CreateDisk(ctx context.Context, request *ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error)
+	AttachDisk(ctx context.Context, request *ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error)
+	DescribeDisks(ctx context.Context, request *ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error)
+	ModifyDiskChargeType(ctx context.Context, request *ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error)
+	ModifyDiskAttribute(ctx context.Context, request *ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error)
+	ModifyDiskSpec(ctx context.Context, request *ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error)
+	ReplaceSystemDisk(ctx context.Context, request *ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error)
+	ReInitDisk(ctx context.Context, request *ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error)
+	ResetDisk(ctx context.Context, request *ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error)
+	ApplyAutoSnapshotPolicy(ctx context.Context, request *ecs.ApplyAutoSnapshotPolicyRequest) (*ecs.ApplyAutoSnapshotPolicyResponse, error)
+	ResizeDisk(ctx context.Context, request *ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error)
+	DetachDisk(ctx context.Context, request *ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error)
+	DeleteDisk(ctx context.Context, request *ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error)
 
 	//Region & Zone
-	DescribeRegions(*ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error)
-	DescribeZones(*ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error)
+	DescribeRegions(ctx context.Context, request *ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error)
+	DescribeZones(ctx context.Context, request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error)
 
 	//Images
-	DescribeImages(*ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error)
+	DescribeImages(ctx context.Context, request *ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error)
+	ImportImage(ctx context.Context, request *ecs.ImportImageRequest) (*ecs.ImportImageResponse, error)
+	CopyImage(ctx context.Context, request *ecs.CopyImageRequest) (*ecs.CopyImageResponse, error)
 
 	//SecurityGroup
-	CreateSecurityGroup(*ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error)
-	AuthorizeSecurityGroup(*ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error)
-	AuthorizeSecurityGroupEgress(*ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error)
-	RevokeSecurityGroup(*ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error)
-	RevokeSecurityGroupEgress(*ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error)
-	JoinSecurityGroup(*ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error)
-	LeaveSecurityGroup(*ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error)
-	DescribeSecurityGroupAttribute(*ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error)
-	DescribeSecurityGroups(*ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error)
-	DescribeSecurityGroupReferences(*ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error)
-	ModifySecurityGroupAttribute(*ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error)
-	ModifySecurityGroupEgressRule(*ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error)
-	ModifySecurityGroupPolicy(*ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error)
-	ModifySecurityGroupRule(*ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error)
-	DeleteSecurityGroup(*ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error)
+	CreateSecurityGroup(ctx context.Context, request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error)
+	AuthorizeSecurityGroup(ctx context.Context, request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error)
+	AuthorizeSecurityGroupEgress(ctx context.Context, request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroup(ctx context.Context, request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error)
+	RevokeSecurityGroupEgress(ctx context.Context, request *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error)
+	JoinSecurityGroup(ctx context.Context, request *ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error)
+	LeaveSecurityGroup(ctx context.Context, request *ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error)
+	DescribeSecurityGroupAttribute(ctx context.Context, request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error)
+	DescribeSecurityGroups(ctx context.Context, request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error)
+	DescribeSecurityGroupReferences(ctx context.Context, request *ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error)
+	ModifySecurityGroupAttribute(ctx context.Context, request *ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error)
+	ModifySecurityGroupEgressRule(ctx context.Context, request *ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error)
+	ModifySecurityGroupPolicy(ctx context.Context, request *ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error)
+	ModifySecurityGroupRule(ctx context.Context, request *ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error)
+	DeleteSecurityGroup(ctx context.Context, request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error)
 
 	//VPC
-	CreateVpc(*vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error)
-	DeleteVpc(*vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error)
-	DescribeVpcs(*vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error)
-	CreateVSwitch(*vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error)
-	DeleteVSwitch(*vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error)
-	DescribeVSwitches(*vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error)
+	CreateVpc(ctx context.Context, request *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error)
+	DeleteVpc(ctx context.Context, request *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error)
+	DescribeVpcs(ctx context.Context, request *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error)
+	CreateVSwitch(ctx context.Context, request *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error)
+	DeleteVSwitch(ctx context.Context, request *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error)
+	DescribeVSwitches(ctx context.Context, request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error)
+	DescribeVSwitchAttributes(ctx context.Context, request *vpc.DescribeVSwitchAttributesRequest) (*vpc.DescribeVSwitchAttributesResponse, error)
 
 	//Natgateway
-	CreateNatGateway(*vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error)
-	DescribeNatGateways(*vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error)
-	DeleteNatGateway(*vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error)
+	CreateNatGateway(ctx context.Context, request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error)
+	DescribeNatGateways(ctx context.Context, request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error)
+	DeleteNatGateway(ctx context.Context, request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error)
 
 	//EIP
-	AllocateEipAddress(*vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error)
-	AssociateEipAddress(*vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error)
-	ModifyEipAddressAttribute(*vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error)
-	DescribeEipAddresses(*vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error)
-	UnassociateEipAddress(*vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error)
-	ReleaseEipAddress(*vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error)
+	AllocateEipAddress(ctx context.Context, request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error)
+	AssociateEipAddress(ctx context.Context, request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error)
+	ModifyEipAddressAttribute(ctx context.Context, request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error)
+	DescribeEipAddresses(ctx context.Context, request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error)
+	UnassociateEipAddress(ctx context.Context, request *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error)
+	ReleaseEipAddress(ctx context.Context, request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error)
 
 	//SLB
-	CreateLoadBalancer(*slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error)
-	DeleteLoadBalancer(*slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error)
-	DescribeLoadBalancers(*slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error)
-	CreateLoadBalancerTCPListener(*slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error)
-	SetLoadBalancerTCPListenerAttribute(*slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error)
-	DescribeLoadBalancerTCPListenerAttribute(*slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error)
-	CreateLoadBalancerUDPListener(*slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error)
-	SetLoadBalancerUDPListenerAttribute(*slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error)
-	DescribeLoadBalancerUDPListenerAttribute(*slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error)
-	CreateLoadBalancerHTTPListener(*slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error)
-	SetLoadBalancerHTTPListenerAttribute(*slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error)
-	DescribeLoadBalancerHTTPListenerAttribute(*slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error)
-	CreateLoadBalancerHTTPSListener(*slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error)
-	SetLoadBalancerHTTPSListenerAttribute(*slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error)
-	DescribeLoadBalancerHTTPSListenerAttribute(*slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error)
-	StartLoadBalancerListener(*slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error)
-	StopLoadBalancerListener(*slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error)
-	DeleteLoadBalancerListener(*slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error)
-	DescribeLoadBalancerListeners(*slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error)
-	AddBackendServers(*slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error)
-	RemoveBackendServers(*slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error)
-	SetBackendServers(*slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error)
-	DescribeHealthStatus(*slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error)
-	CreateVServerGroup(*slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error)
-	SetVServerGroupAttribute(*slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error)
-	AddVServerGroupBackendServers(*slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error)
-	RemoveVServerGroupBackendServers(*slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error)
-	ModifyVServerGroupBackendServers(*slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error)
-	DeleteVServerGroup(*slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error)
-	DescribeVServerGroups(*slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error)
-	DescribeVServerGroupAttribute(*slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error)
+	CreateLoadBalancer(ctx context.Context, request *slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error)
+	DeleteLoadBalancer(ctx context.Context, request *slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error)
+	DescribeLoadBalancers(ctx context.Context, request *slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error)
+	CreateLoadBalancerTCPListener(ctx context.Context, request *slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error)
+	SetLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error)
+	DescribeLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error)
+	CreateLoadBalancerUDPListener(ctx context.Context, request *slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error)
+	SetLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error)
+	DescribeLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error)
+	CreateLoadBalancerHTTPListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error)
+	SetLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error)
+	DescribeLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error)
+	CreateLoadBalancerHTTPSListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error)
+	SetLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error)
+	DescribeLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error)
+	StartLoadBalancerListener(ctx context.Context, request *slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error)
+	StopLoadBalancerListener(ctx context.Context, request *slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error)
+	DeleteLoadBalancerListener(ctx context.Context, request *slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error)
+	DescribeLoadBalancerListeners(ctx context.Context, request *slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error)
+	AddBackendServers(ctx context.Context, request *slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error)
+	RemoveBackendServers(ctx context.Context, request *slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error)
+	SetBackendServers(ctx context.Context, request *slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error)
+	DescribeHealthStatus(ctx context.Context, request *slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error)
+	CreateVServerGroup(ctx context.Context, request *slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error)
+	SetVServerGroupAttribute(ctx context.Context, request *slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error)
+	AddVServerGroupBackendServers(ctx context.Context, request *slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error)
+	RemoveVServerGroupBackendServers(ctx context.Context, request *slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error)
+	ModifyVServerGroupBackendServers(ctx context.Context, request *slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error)
+	DeleteVServerGroup(ctx context.Context, request *slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error)
+	DescribeVServerGroups(ctx context.Context, request *slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error)
+	DescribeVServerGroupAttribute(ctx context.Context, request *slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error)
 }
 
 type alibabacloudClient struct {
 	ecsClient *ecs.Client
 	vpcClient *vpc.Client
 	slbClient *slb.Client
-}
-
-func (client *alibabacloudClient) RunInstances(request *ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error) {
-	return client.ecsClient.RunInstances(request)
-}
-
-func (client *alibabacloudClient) CreateInstance(request *ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error) {
-	return client.ecsClient.CreateInstance(request)
-}
-
-func (client *alibabacloudClient) DescribeInstances(request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
-	return client.ecsClient.DescribeInstances(request)
-}
-
-func (client *alibabacloudClient) DeleteInstances(request *ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error) {
-	return client.ecsClient.DeleteInstances(request)
-}
-
-func (client *alibabacloudClient) StartInstance(request *ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error) {
-	return client.ecsClient.StartInstance(request)
-}
-
-func (client *alibabacloudClient) RebootInstance(request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error) {
-	return client.ecsClient.RebootInstance(request)
-}
-
-func (client *alibabacloudClient) StopInstance(request *ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error) {
-	return client.ecsClient.StopInstance(request)
-}
-
-func (client *alibabacloudClient) StartInstances(request *ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error) {
-	return client.ecsClient.StartInstances(request)
-}
-
-func (client *alibabacloudClient) RebootInstances(request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error) {
-	return client.ecsClient.RebootInstances(request)
-}
-
-func (client *alibabacloudClient) StopInstances(request *ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error) {
-	return client.ecsClient.StopInstances(request)
-}
-
-func (client *alibabacloudClient) DeleteInstance(request *ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error) {
-	return client.ecsClient.DeleteInstance(request)
-}
-
-func (client *alibabacloudClient) AttachInstanceRAMRole(request *ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error) {
-	return client.ecsClient.AttachInstanceRamRole(request)
-}
-
-func (client *alibabacloudClient) DetachInstanceRAMRole(request *ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error) {
-	return client.ecsClient.DetachInstanceRamRole(request)
-}
-
-func (client *alibabacloudClient) DescribeInstanceStatus(request *ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error) {
-	return client.ecsClient.DescribeInstanceStatus(request)
-}
-
-func (client *alibabacloudClient) ReActivateInstances(request *ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error) {
-	return client.ecsClient.ReActivateInstances(request)
-}
-
-func (client *alibabacloudClient) DescribeUserData(request *ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error) {
-	return client.ecsClient.DescribeUserData(request)
-}
-
-func (client *alibabacloudClient) DescribeInstanceTypes(request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
-	return client.ecsClient.DescribeInstanceTypes(request)
-}
-
-func (client *alibabacloudClient) ModifyInstanceAttribute(request *ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error) {
-	return client.ecsClient.ModifyInstanceAttribute(request)
-}
-
-func (client *alibabacloudClient) ModifyInstanceMetadataOptions(request *ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error) {
-	return client.ecsClient.ModifyInstanceMetadataOptions(request)
-}
-
-func (client *alibabacloudClient) AllocatePublicIPAddress(request *ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error) {
-	return client.ecsClient.AllocatePublicIpAddress(request)
-}
-
-func (client *alibabacloudClient) CreateDisk(request *ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error) {
-	return client.ecsClient.CreateDisk(request)
-}
-
-func (client *alibabacloudClient) AttachDisk(request *ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error) {
-	return client.ecsClient.AttachDisk(request)
-}
-
-func (client *alibabacloudClient) DescribeDisks(request *ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error) {
-	return client.ecsClient.DescribeDisks(request)
-}
-
-func (client *alibabacloudClient) ModifyDiskChargeType(request *ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error) {
-	return client.ecsClient.ModifyDiskChargeType(request)
-}
-
-func (client *alibabacloudClient) ModifyDiskAttribute(request *ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error) {
-	return client.ecsClient.ModifyDiskAttribute(request)
-}
-
-func (client *alibabacloudClient) ModifyDiskSpec(request *ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error) {
-	return client.ecsClient.ModifyDiskSpec(request)
-}
-
-func (client *alibabacloudClient) ReplaceSystemDisk(request *ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error) {
-	return client.ecsClient.ReplaceSystemDisk(request)
-}
-
-func (client *alibabacloudClient) ReInitDisk(request *ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error) {
-	return client.ecsClient.ReInitDisk(request)
-}
-
-func (client *alibabacloudClient) ResetDisk(request *ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error) {
-	return client.ecsClient.ResetDisk(request)
-}
-
-func (client *alibabacloudClient) ResizeDisk(request *ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error) {
-	return client.ecsClient.ResizeDisk(request)
-}
-
-func (client *alibabacloudClient) DetachDisk(request *ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error) {
-	return client.ecsClient.DetachDisk(request)
-}
-
-func (client *alibabacloudClient) DeleteDisk(request *ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error) {
-	return client.ecsClient.DeleteDisk(request)
-}
-
-func (client *alibabacloudClient) DescribeRegions(request *ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error) {
-	return client.ecsClient.DescribeRegions(request)
-}
-
-func (client *alibabacloudClient) DescribeZones(request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error) {
-	return client.ecsClient.DescribeZones(request)
-}
-
-func (client *alibabacloudClient) DescribeImages(request *ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error) {
-	return client.ecsClient.DescribeImages(request)
-}
-
-func (client *alibabacloudClient) CreateSecurityGroup(request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
-	return client.ecsClient.CreateSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) AuthorizeSecurityGroup(request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
-	return client.ecsClient.AuthorizeSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) AuthorizeSecurityGroupEgress(request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
-	return client.ecsClient.AuthorizeSecurityGroupEgress(request)
-}
-
-func (client *alibabacloudClient) RevokeSecurityGroup(request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
-	return client.ecsClient.RevokeSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) RevokeSecurityGroupEgress(request *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error) {
-	return client.ecsClient.RevokeSecurityGroupEgress(request)
-}
-
-func (client *alibabacloudClient) JoinSecurityGroup(request *ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error) {
-	return client.ecsClient.JoinSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) LeaveSecurityGroup(request *ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error) {
-	return client.ecsClient.LeaveSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) DescribeSecurityGroupAttribute(request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
-	return client.ecsClient.DescribeSecurityGroupAttribute(request)
-}
-
-func (client *alibabacloudClient) DescribeSecurityGroups(request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
-	return client.ecsClient.DescribeSecurityGroups(request)
-}
-
-func (client *alibabacloudClient) DescribeSecurityGroupReferences(request *ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error) {
-	return client.ecsClient.DescribeSecurityGroupReferences(request)
-}
-
-func (client *alibabacloudClient) ModifySecurityGroupAttribute(request *ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error) {
-	return client.ecsClient.ModifySecurityGroupAttribute(request)
-}
-
-func (client *alibabacloudClient) ModifySecurityGroupEgressRule(request *ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error) {
-	return client.ecsClient.ModifySecurityGroupEgressRule(request)
-}
-
-func (client *alibabacloudClient) ModifySecurityGroupPolicy(request *ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error) {
-	return client.ecsClient.ModifySecurityGroupPolicy(request)
-}
-
-func (client *alibabacloudClient) ModifySecurityGroupRule(request *ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error) {
-	return client.ecsClient.ModifySecurityGroupRule(request)
-}
-
-func (client *alibabacloudClient) DeleteSecurityGroup(request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
-	return client.ecsClient.DeleteSecurityGroup(request)
-}
-
-func (client *alibabacloudClient) TagResources(request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
-	return client.ecsClient.TagResources(request)
-}
-
-func (client *alibabacloudClient) ListTagResources(request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
-	return client.ecsClient.ListTagResources(request)
-}
-
-func (client *alibabacloudClient) UntagResources(request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
-	return client.ecsClient.UntagResources(request)
-}
-
-func (client *alibabacloudClient) CreateVpc(request *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error) {
-	return client.vpcClient.CreateVpc(request)
-}
-
-func (client *alibabacloudClient) DeleteVpc(request *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error) {
-	return client.vpcClient.DeleteVpc(request)
-}
-
-func (client *alibabacloudClient) DescribeVpcs(request *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error) {
-	return client.vpcClient.DescribeVpcs(request)
-}
-
-func (client *alibabacloudClient) CreateVSwitch(request *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error) {
-	return client.vpcClient.CreateVSwitch(request)
-}
-
-func (client *alibabacloudClient) DeleteVSwitch(request *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error) {
-	return client.vpcClient.DeleteVSwitch(request)
-}
-
-func (client *alibabacloudClient) DescribeVSwitches(request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
-	return client.vpcClient.DescribeVSwitches(request)
-}
-
-func (client *alibabacloudClient) CreateNatGateway(request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
-	return client.vpcClient.CreateNatGateway(request)
-}
-
-func (client *alibabacloudClient) DescribeNatGateways(request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
-	return client.vpcClient.DescribeNatGateways(request)
-}
-
-func (client *alibabacloudClient) DeleteNatGateway(request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
-	return client.vpcClient.DeleteNatGateway(request)
-}
-
-func (client *alibabacloudClient) AllocateEipAddress(request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
-	return client.vpcClient.AllocateEipAddress(request)
-}
-
-func (client *alibabacloudClient) AssociateEipAddress(request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
-	return client.vpcClient.AssociateEipAddress(request)
-}
-
-func (client *alibabacloudClient) ModifyEipAddressAttribute(request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
-	return client.vpcClient.ModifyEipAddressAttribute(request)
-}
-
-func (client *alibabacloudClient) DescribeEipAddresses(request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
-	return client.vpcClient.DescribeEipAddresses(request)
-}
-
-func (client *alibabacloudClient) UnassociateEipAddress(request *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
-	return client.vpcClient.UnassociateEipAddress(request)
-}
-
-func (client *alibabacloudClient) ReleaseEipAddress(request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
-	return client.vpcClient.ReleaseEipAddress(request)
-}
-
-func (client *alibabacloudClient) CreateLoadBalancer(request *slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error) {
-	return client.slbClient.CreateLoadBalancer(request)
-}
-
-func (client *alibabacloudClient) DeleteLoadBalancer(request *slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error) {
-	return client.slbClient.DeleteLoadBalancer(request)
-}
-
-func (client *alibabacloudClient) DescribeLoadBalancers(request *slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error) {
-	return client.slbClient.DescribeLoadBalancers(request)
-}
-
-func (client *alibabacloudClient) CreateLoadBalancerTCPListener(request *slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error) {
-	return client.slbClient.CreateLoadBalancerTCPListener(request)
-}
-
-func (client *alibabacloudClient) SetLoadBalancerTCPListenerAttribute(request *slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error) {
-	return client.slbClient.SetLoadBalancerTCPListenerAttribute(request)
-}
-
-func (client *alibabacloudClient) DescribeLoadBalancerTCPListenerAttribute(request *slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
-	return client.slbClient.DescribeLoadBalancerTCPListenerAttribute(request)
-}
-
-func (client *alibabacloudClient) CreateLoadBalancerUDPListener(request *slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error) {
-	return client.slbClient.CreateLoadBalancerUDPListener(request)
-}
-
-func (client *alibabacloudClient) SetLoadBalancerUDPListenerAttribute(request *slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error) {
-	return client.slbClient.SetLoadBalancerUDPListenerAttribute(request)
-}
 
-func (client *alibabacloudClient) DescribeLoadBalancerUDPListenerAttribute(request *slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
-	return client.slbClient.DescribeLoadBalancerUDPListenerAttribute(request)
-}
-
-func (client *alibabacloudClient) CreateLoadBalancerHTTPListener(request *slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error) {
-	return client.slbClient.CreateLoadBalancerHTTPListener(request)
-}
-
-func (client *alibabacloudClient) SetLoadBalancerHTTPListenerAttribute(request *slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error) {
-	return client.slbClient.SetLoadBalancerHTTPListenerAttribute(request)
+	// ecsLimiter, vpcLimiter and slbLimiter throttle outgoing calls to each Alibaba Cloud API
+	// group. They are shared across every reconcile that uses this client, since they are set
+	// once in NewClient and the client itself is cached and reused, so a machineset scaling up
+	// many machines at once is rate limited client-side instead of tripping account-wide API
+	// flow control on the Alibaba Cloud side.
+	ecsLimiter *rate.Limiter
+	vpcLimiter *rate.Limiter
+	slbLimiter *rate.Limiter
+
+	// ecsEndpoint, vpcEndpoint and slbEndpoint override SDK default public endpoint resolution for
+	// their respective service when non-empty, for clusters using VPC endpoints or Alibaba
+	// Gov/Finance cloud domains. See EndpointOverrides.
+	ecsEndpoint string
+	vpcEndpoint string
+	slbEndpoint string
+}
+
+// applyEndpointOverride sets request's domain to endpoint when endpoint is non-empty, overriding
+// the SDK's own location-service-based endpoint resolution for that call.
+func applyEndpointOverride(request requests.AcsRequest, endpoint string) {
+	if endpoint != "" {
+		request.SetDomain(endpoint)
+	}
 }
 
-func (client *alibabacloudClient) DescribeLoadBalancerHTTPListenerAttribute(request *slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
-	return client.slbClient.DescribeLoadBalancerHTTPListenerAttribute(request)
-}
+const (
+	// defaultAPIRateLimit is the default sustained request rate allowed per API group, in
+	// requests per second.
+	defaultAPIRateLimit rate.Limit = 10
 
-func (client *alibabacloudClient) CreateLoadBalancerHTTPSListener(request *slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error) {
-	return client.slbClient.CreateLoadBalancerHTTPSListener(request)
-}
+	// defaultAPIRateBurst is the default burst size allowed per API group on top of the
+	// sustained rate, absorbing short spikes like a machineset scaling up.
+	defaultAPIRateBurst = 20
+)
 
-func (client *alibabacloudClient) SetLoadBalancerHTTPSListenerAttribute(request *slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error) {
-	return client.slbClient.SetLoadBalancerHTTPSListenerAttribute(request)
+// newAPIRateLimiter returns a token-bucket limiter using the package defaults for sustained rate
+// and burst size.
+func newAPIRateLimiter() *rate.Limiter {
+	return rate.NewLimiter(defaultAPIRateLimit, defaultAPIRateBurst)
 }
 
-func (client *alibabacloudClient) DescribeLoadBalancerHTTPSListenerAttribute(request *slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
-	return client.slbClient.DescribeLoadBalancerHTTPSListenerAttribute(request)
-}
+const (
+	// throttleRetryMaxAttempts bounds how many attempts, the first one included, a throttled call
+	// gets before the throttling error is returned to the caller.
+	throttleRetryMaxAttempts = 5
 
-func (client *alibabacloudClient) StartLoadBalancerListener(request *slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error) {
-	return client.slbClient.StartLoadBalancerListener(request)
-}
+	// throttleRetryBaseDelay is the initial backoff delay before the first retry of a throttled
+	// call; it doubles on each subsequent attempt up to throttleRetryMaxDelay.
+	throttleRetryBaseDelay = 200 * time.Millisecond
 
-func (client *alibabacloudClient) StopLoadBalancerListener(request *slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error) {
-	return client.slbClient.StopLoadBalancerListener(request)
-}
+	// throttleRetryMaxDelay caps the backoff delay between retries of a throttled call.
+	throttleRetryMaxDelay = 10 * time.Second
+)
 
-func (client *alibabacloudClient) DeleteLoadBalancerListener(request *slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error) {
-	return client.slbClient.DeleteLoadBalancerListener(request)
-}
+// throttledCallCount counts Alibaba Cloud SDK calls that were retried because the API responded
+// with a throttling error, labeled by outcome: "succeeded" once a retry gets a response that is not
+// a throttling error, "exhausted" when every attempt in the budget was throttled.
+var throttledCallCount = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "alibabacloud_client_throttled_total",
+		Help: "Number of Alibaba Cloud SDK calls retried due to a throttling error.",
+	}, []string{"outcome"},
+)
 
-func (client *alibabacloudClient) DescribeLoadBalancerListeners(request *slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error) {
-	return client.slbClient.DescribeLoadBalancerListeners(request)
-}
+// apiRequestsTotal and apiRequestDuration instrument every Alibaba Cloud API call made through
+// this client, for capacity planning and SLO monitoring. Each call attempt is recorded once, so a
+// call retried by retryOnThrottle contributes one sample per attempt.
+var (
+	apiRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "alibabacloud_api_requests_total",
+			Help: "Total number of Alibaba Cloud API requests, labeled by action and error code.",
+		}, []string{"action", "error_code"},
+	)
+
+	apiRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "alibabacloud_api_request_duration_seconds",
+			Help:    "Latency of Alibaba Cloud API requests, labeled by action.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"action"},
+	)
+)
 
-func (client *alibabacloudClient) AddBackendServers(request *slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error) {
-	return client.slbClient.AddBackendServers(request)
+func init() {
+	prometheus.MustRegister(throttledCallCount, apiRequestsTotal, apiRequestDuration)
 }
 
-func (client *alibabacloudClient) RemoveBackendServers(request *slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error) {
-	return client.slbClient.RemoveBackendServers(request)
-}
+// retryOnThrottle waits for limiter to admit the call, then invokes call, retrying with jittered
+// exponential backoff while the Alibaba Cloud API responds with a Throttling error, up to
+// throttleRetryMaxAttempts attempts. Any other error, or a throttling error past the retry
+// budget, is returned as-is to the caller. Every attempt is recorded against action in
+// apiRequestsTotal and apiRequestDuration.
+func retryOnThrottle(ctx context.Context, action string, limiter *rate.Limiter, call func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 
-func (client *alibabacloudClient) SetBackendServers(request *slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error) {
-	return client.slbClient.SetBackendServers(request)
-}
+	delay := throttleRetryBaseDelay
+	var err error
+	for attempt := 0; attempt < throttleRetryMaxAttempts; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
 
-func (client *alibabacloudClient) DescribeHealthStatus(request *slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error) {
-	return client.slbClient.DescribeHealthStatus(request)
-}
+		start := time.Now()
+		err = callWithContext(ctx, call)
+		apiRequestDuration.WithLabelValues(action).Observe(time.Since(start).Seconds())
+		apiRequestsTotal.WithLabelValues(action, clienterrors.Code(err)).Inc()
 
-func (client *alibabacloudClient) CreateVServerGroup(request *slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error) {
-	return client.slbClient.CreateVServerGroup(request)
-}
+		if clienterrors.Classify(err) != clienterrors.CategoryThrottling {
+			if attempt > 0 {
+				throttledCallCount.WithLabelValues("succeeded").Inc()
+			}
+			return err
+		}
 
-func (client *alibabacloudClient) SetVServerGroupAttribute(request *slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error) {
-	return client.slbClient.SetVServerGroupAttribute(request)
-}
+		if attempt == throttleRetryMaxAttempts-1 {
+			break
+		}
 
-func (client *alibabacloudClient) AddVServerGroupBackendServers(request *slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error) {
-	return client.slbClient.AddVServerGroupBackendServers(request)
-}
+		klog.Warningf("alibabacloud API call throttled, retrying in %s (attempt %d/%d): %v", delay, attempt+1, throttleRetryMaxAttempts, err)
+		jitter := time.Duration(rand.Int63n(int64(delay) / 2))
+		select {
+		case <-time.After(delay + jitter):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 
-func (client *alibabacloudClient) RemoveVServerGroupBackendServers(request *slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error) {
-	return client.slbClient.RemoveVServerGroupBackendServers(request)
-}
+		delay *= 2
+		if delay > throttleRetryMaxDelay {
+			delay = throttleRetryMaxDelay
+		}
+	}
 
-func (client *alibabacloudClient) ModifyVServerGroupBackendServers(request *slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error) {
-	return client.slbClient.ModifyVServerGroupBackendServers(request)
+	throttledCallCount.WithLabelValues("exhausted").Inc()
+	return err
 }
 
-func (client *alibabacloudClient) DeleteVServerGroup(request *slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error) {
-	return client.slbClient.DeleteVServerGroup(request)
-}
+// callWithContext runs call in a goroutine and returns as soon as either it completes or ctx is
+// done. The vendored Alibaba Cloud SDK does not accept a context.Context on individual requests,
+// so this is the only way to make a reconcile's context deadline/cancellation actually unblock the
+// caller; the underlying HTTP request itself is not aborted and call's goroutine is left to finish
+// in the background.
+func callWithContext(ctx context.Context, call func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- call() }()
 
-func (client *alibabacloudClient) DescribeVServerGroups(request *slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error) {
-	return client.slbClient.DescribeVServerGroups(request)
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
-func (client *alibabacloudClient) DescribeVServerGroupAttribute(request *slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error) {
-	return client.slbClient.DescribeVServerGroupAttribute(request)
+func (client *alibabacloudClient) RunInstances(ctx context.Context, request *ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RunInstancesResponse
+	err := retryOnThrottle(ctx, "RunInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RunInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateInstance(ctx context.Context, request *ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.CreateInstanceResponse
+	err := retryOnThrottle(ctx, "CreateInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.CreateInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeInstances(ctx context.Context, request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeInstancesResponse
+	err := retryOnThrottle(ctx, "DescribeInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteInstances(ctx context.Context, request *ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DeleteInstancesResponse
+	err := retryOnThrottle(ctx, "DeleteInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DeleteInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StartInstance(ctx context.Context, request *ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.StartInstanceResponse
+	err := retryOnThrottle(ctx, "StartInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.StartInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RebootInstance(ctx context.Context, request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RebootInstanceResponse
+	err := retryOnThrottle(ctx, "RebootInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RebootInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StopInstance(ctx context.Context, request *ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.StopInstanceResponse
+	err := retryOnThrottle(ctx, "StopInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.StopInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StartInstances(ctx context.Context, request *ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.StartInstancesResponse
+	err := retryOnThrottle(ctx, "StartInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.StartInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RebootInstances(ctx context.Context, request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RebootInstancesResponse
+	err := retryOnThrottle(ctx, "RebootInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RebootInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StopInstances(ctx context.Context, request *ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.StopInstancesResponse
+	err := retryOnThrottle(ctx, "StopInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.StopInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteInstance(ctx context.Context, request *ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DeleteInstanceResponse
+	err := retryOnThrottle(ctx, "DeleteInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DeleteInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AttachInstanceRAMRole(ctx context.Context, request *ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.AttachInstanceRamRoleResponse
+	err := retryOnThrottle(ctx, "AttachInstanceRAMRole", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.AttachInstanceRamRole(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DetachInstanceRAMRole(ctx context.Context, request *ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DetachInstanceRamRoleResponse
+	err := retryOnThrottle(ctx, "DetachInstanceRAMRole", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DetachInstanceRamRole(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeInstanceStatus(ctx context.Context, request *ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeInstanceStatusResponse
+	err := retryOnThrottle(ctx, "DescribeInstanceStatus", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeInstanceStatus(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeInstancesFullStatus(ctx context.Context, request *ecs.DescribeInstancesFullStatusRequest) (*ecs.DescribeInstancesFullStatusResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeInstancesFullStatusResponse
+	err := retryOnThrottle(ctx, "DescribeInstancesFullStatus", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeInstancesFullStatus(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RedeployInstance(ctx context.Context, request *ecs.RedeployInstanceRequest) (*ecs.RedeployInstanceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RedeployInstanceResponse
+	err := retryOnThrottle(ctx, "RedeployInstance", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RedeployInstance(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ReActivateInstances(ctx context.Context, request *ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ReActivateInstancesResponse
+	err := retryOnThrottle(ctx, "ReActivateInstances", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ReActivateInstances(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeUserData(ctx context.Context, request *ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeUserDataResponse
+	err := retryOnThrottle(ctx, "DescribeUserData", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeUserData(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeInstanceTypes(ctx context.Context, request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeInstanceTypesResponse
+	err := retryOnThrottle(ctx, "DescribeInstanceTypes", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeInstanceTypes(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeAvailableResource(ctx context.Context, request *ecs.DescribeAvailableResourceRequest) (*ecs.DescribeAvailableResourceResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeAvailableResourceResponse
+	err := retryOnThrottle(ctx, "DescribeAvailableResource", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeAvailableResource(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyInstanceAttribute(ctx context.Context, request *ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyInstanceAttributeResponse
+	err := retryOnThrottle(ctx, "ModifyInstanceAttribute", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyInstanceAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyInstanceMetadataOptions(ctx context.Context, request *ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyInstanceMetadataOptionsResponse
+	err := retryOnThrottle(ctx, "ModifyInstanceMetadataOptions", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyInstanceMetadataOptions(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyInstanceSpec(ctx context.Context, request *ecs.ModifyInstanceSpecRequest) (*ecs.ModifyInstanceSpecResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyInstanceSpecResponse
+	err := retryOnThrottle(ctx, "ModifyInstanceSpec", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyInstanceSpec(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) GetInstanceConsoleOutput(ctx context.Context, request *ecs.GetInstanceConsoleOutputRequest) (*ecs.GetInstanceConsoleOutputResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.GetInstanceConsoleOutputResponse
+	err := retryOnThrottle(ctx, "GetInstanceConsoleOutput", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.GetInstanceConsoleOutput(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) GetInstanceScreenshot(ctx context.Context, request *ecs.GetInstanceScreenshotRequest) (*ecs.GetInstanceScreenshotResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.GetInstanceScreenshotResponse
+	err := retryOnThrottle(ctx, "GetInstanceScreenshot", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.GetInstanceScreenshot(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AllocatePublicIPAddress(ctx context.Context, request *ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.AllocatePublicIpAddressResponse
+	err := retryOnThrottle(ctx, "AllocatePublicIPAddress", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.AllocatePublicIpAddress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeNetworkInterfaces(ctx context.Context, request *ecs.DescribeNetworkInterfacesRequest) (*ecs.DescribeNetworkInterfacesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeNetworkInterfacesResponse
+	err := retryOnThrottle(ctx, "DescribeNetworkInterfaces", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeNetworkInterfaces(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyNetworkInterfaceAttribute(ctx context.Context, request *ecs.ModifyNetworkInterfaceAttributeRequest) (*ecs.ModifyNetworkInterfaceAttributeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyNetworkInterfaceAttributeResponse
+	err := retryOnThrottle(ctx, "ModifyNetworkInterfaceAttribute", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyNetworkInterfaceAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateDisk(ctx context.Context, request *ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.CreateDiskResponse
+	err := retryOnThrottle(ctx, "CreateDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.CreateDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AttachDisk(ctx context.Context, request *ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.AttachDiskResponse
+	err := retryOnThrottle(ctx, "AttachDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.AttachDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeDisks(ctx context.Context, request *ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeDisksResponse
+	err := retryOnThrottle(ctx, "DescribeDisks", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeDisks(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyDiskChargeType(ctx context.Context, request *ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyDiskChargeTypeResponse
+	err := retryOnThrottle(ctx, "ModifyDiskChargeType", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyDiskChargeType(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyDiskAttribute(ctx context.Context, request *ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyDiskAttributeResponse
+	err := retryOnThrottle(ctx, "ModifyDiskAttribute", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyDiskAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyDiskSpec(ctx context.Context, request *ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifyDiskSpecResponse
+	err := retryOnThrottle(ctx, "ModifyDiskSpec", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifyDiskSpec(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ReplaceSystemDisk(ctx context.Context, request *ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ReplaceSystemDiskResponse
+	err := retryOnThrottle(ctx, "ReplaceSystemDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ReplaceSystemDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ReInitDisk(ctx context.Context, request *ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ReInitDiskResponse
+	err := retryOnThrottle(ctx, "ReInitDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ReInitDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ResetDisk(ctx context.Context, request *ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ResetDiskResponse
+	err := retryOnThrottle(ctx, "ResetDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ResetDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ApplyAutoSnapshotPolicy(ctx context.Context, request *ecs.ApplyAutoSnapshotPolicyRequest) (*ecs.ApplyAutoSnapshotPolicyResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ApplyAutoSnapshotPolicyResponse
+	err := retryOnThrottle(ctx, "ApplyAutoSnapshotPolicy", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ApplyAutoSnapshotPolicy(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ResizeDisk(ctx context.Context, request *ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ResizeDiskResponse
+	err := retryOnThrottle(ctx, "ResizeDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ResizeDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DetachDisk(ctx context.Context, request *ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DetachDiskResponse
+	err := retryOnThrottle(ctx, "DetachDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DetachDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteDisk(ctx context.Context, request *ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DeleteDiskResponse
+	err := retryOnThrottle(ctx, "DeleteDisk", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DeleteDisk(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeRegions(ctx context.Context, request *ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeRegionsResponse
+	err := retryOnThrottle(ctx, "DescribeRegions", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeRegions(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeZones(ctx context.Context, request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeZonesResponse
+	err := retryOnThrottle(ctx, "DescribeZones", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeZones(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeImages(ctx context.Context, request *ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeImagesResponse
+	err := retryOnThrottle(ctx, "DescribeImages", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeImages(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ImportImage(ctx context.Context, request *ecs.ImportImageRequest) (*ecs.ImportImageResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ImportImageResponse
+	err := retryOnThrottle(ctx, "ImportImage", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ImportImage(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CopyImage(ctx context.Context, request *ecs.CopyImageRequest) (*ecs.CopyImageResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.CopyImageResponse
+	err := retryOnThrottle(ctx, "CopyImage", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.CopyImage(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateSecurityGroup(ctx context.Context, request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.CreateSecurityGroupResponse
+	err := retryOnThrottle(ctx, "CreateSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.CreateSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AuthorizeSecurityGroup(ctx context.Context, request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.AuthorizeSecurityGroupResponse
+	err := retryOnThrottle(ctx, "AuthorizeSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.AuthorizeSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AuthorizeSecurityGroupEgress(ctx context.Context, request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.AuthorizeSecurityGroupEgressResponse
+	err := retryOnThrottle(ctx, "AuthorizeSecurityGroupEgress", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.AuthorizeSecurityGroupEgress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RevokeSecurityGroup(ctx context.Context, request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RevokeSecurityGroupResponse
+	err := retryOnThrottle(ctx, "RevokeSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RevokeSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RevokeSecurityGroupEgress(ctx context.Context, request *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.RevokeSecurityGroupEgressResponse
+	err := retryOnThrottle(ctx, "RevokeSecurityGroupEgress", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.RevokeSecurityGroupEgress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) JoinSecurityGroup(ctx context.Context, request *ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.JoinSecurityGroupResponse
+	err := retryOnThrottle(ctx, "JoinSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.JoinSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) LeaveSecurityGroup(ctx context.Context, request *ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.LeaveSecurityGroupResponse
+	err := retryOnThrottle(ctx, "LeaveSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.LeaveSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeSecurityGroupAttribute(ctx context.Context, request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeSecurityGroupAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeSecurityGroupAttribute", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeSecurityGroupAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeSecurityGroups(ctx context.Context, request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeSecurityGroupsResponse
+	err := retryOnThrottle(ctx, "DescribeSecurityGroups", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeSecurityGroups(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeSecurityGroupReferences(ctx context.Context, request *ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DescribeSecurityGroupReferencesResponse
+	err := retryOnThrottle(ctx, "DescribeSecurityGroupReferences", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DescribeSecurityGroupReferences(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifySecurityGroupAttribute(ctx context.Context, request *ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifySecurityGroupAttributeResponse
+	err := retryOnThrottle(ctx, "ModifySecurityGroupAttribute", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifySecurityGroupAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifySecurityGroupEgressRule(ctx context.Context, request *ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifySecurityGroupEgressRuleResponse
+	err := retryOnThrottle(ctx, "ModifySecurityGroupEgressRule", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifySecurityGroupEgressRule(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifySecurityGroupPolicy(ctx context.Context, request *ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifySecurityGroupPolicyResponse
+	err := retryOnThrottle(ctx, "ModifySecurityGroupPolicy", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifySecurityGroupPolicy(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifySecurityGroupRule(ctx context.Context, request *ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ModifySecurityGroupRuleResponse
+	err := retryOnThrottle(ctx, "ModifySecurityGroupRule", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ModifySecurityGroupRule(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteSecurityGroup(ctx context.Context, request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.DeleteSecurityGroupResponse
+	err := retryOnThrottle(ctx, "DeleteSecurityGroup", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.DeleteSecurityGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) TagResources(ctx context.Context, request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.TagResourcesResponse
+	err := retryOnThrottle(ctx, "TagResources", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.TagResources(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ListTagResources(ctx context.Context, request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.ListTagResourcesResponse
+	err := retryOnThrottle(ctx, "ListTagResources", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.ListTagResources(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) UntagResources(ctx context.Context, request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
+	applyEndpointOverride(request, client.ecsEndpoint)
+	var response *ecs.UntagResourcesResponse
+	err := retryOnThrottle(ctx, "UntagResources", client.ecsLimiter, func() error {
+		var err error
+		response, err = client.ecsClient.UntagResources(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateVpc(ctx context.Context, request *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.CreateVpcResponse
+	err := retryOnThrottle(ctx, "CreateVpc", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.CreateVpc(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteVpc(ctx context.Context, request *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DeleteVpcResponse
+	err := retryOnThrottle(ctx, "DeleteVpc", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DeleteVpc(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeVpcs(ctx context.Context, request *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DescribeVpcsResponse
+	err := retryOnThrottle(ctx, "DescribeVpcs", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DescribeVpcs(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateVSwitch(ctx context.Context, request *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.CreateVSwitchResponse
+	err := retryOnThrottle(ctx, "CreateVSwitch", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.CreateVSwitch(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteVSwitch(ctx context.Context, request *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DeleteVSwitchResponse
+	err := retryOnThrottle(ctx, "DeleteVSwitch", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DeleteVSwitch(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeVSwitches(ctx context.Context, request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DescribeVSwitchesResponse
+	err := retryOnThrottle(ctx, "DescribeVSwitches", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DescribeVSwitches(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeVSwitchAttributes(ctx context.Context, request *vpc.DescribeVSwitchAttributesRequest) (*vpc.DescribeVSwitchAttributesResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DescribeVSwitchAttributesResponse
+	err := retryOnThrottle(ctx, "DescribeVSwitchAttributes", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DescribeVSwitchAttributes(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateNatGateway(ctx context.Context, request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.CreateNatGatewayResponse
+	err := retryOnThrottle(ctx, "CreateNatGateway", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.CreateNatGateway(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeNatGateways(ctx context.Context, request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DescribeNatGatewaysResponse
+	err := retryOnThrottle(ctx, "DescribeNatGateways", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DescribeNatGateways(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteNatGateway(ctx context.Context, request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DeleteNatGatewayResponse
+	err := retryOnThrottle(ctx, "DeleteNatGateway", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DeleteNatGateway(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AllocateEipAddress(ctx context.Context, request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.AllocateEipAddressResponse
+	err := retryOnThrottle(ctx, "AllocateEipAddress", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.AllocateEipAddress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AssociateEipAddress(ctx context.Context, request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.AssociateEipAddressResponse
+	err := retryOnThrottle(ctx, "AssociateEipAddress", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.AssociateEipAddress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyEipAddressAttribute(ctx context.Context, request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.ModifyEipAddressAttributeResponse
+	err := retryOnThrottle(ctx, "ModifyEipAddressAttribute", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.ModifyEipAddressAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeEipAddresses(ctx context.Context, request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.DescribeEipAddressesResponse
+	err := retryOnThrottle(ctx, "DescribeEipAddresses", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.DescribeEipAddresses(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) UnassociateEipAddress(ctx context.Context, request *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.UnassociateEipAddressResponse
+	err := retryOnThrottle(ctx, "UnassociateEipAddress", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.UnassociateEipAddress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ReleaseEipAddress(ctx context.Context, request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
+	applyEndpointOverride(request, client.vpcEndpoint)
+	var response *vpc.ReleaseEipAddressResponse
+	err := retryOnThrottle(ctx, "ReleaseEipAddress", client.vpcLimiter, func() error {
+		var err error
+		response, err = client.vpcClient.ReleaseEipAddress(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateLoadBalancer(ctx context.Context, request *slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateLoadBalancerResponse
+	err := retryOnThrottle(ctx, "CreateLoadBalancer", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateLoadBalancer(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteLoadBalancer(ctx context.Context, request *slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DeleteLoadBalancerResponse
+	err := retryOnThrottle(ctx, "DeleteLoadBalancer", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DeleteLoadBalancer(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancers(ctx context.Context, request *slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancersResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateLoadBalancerTCPListener(ctx context.Context, request *slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateLoadBalancerTCPListenerResponse
+	err := retryOnThrottle(ctx, "CreateLoadBalancerTCPListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateLoadBalancerTCPListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetLoadBalancerTCPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "SetLoadBalancerTCPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetLoadBalancerTCPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancerTCPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancerTCPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancerTCPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateLoadBalancerUDPListener(ctx context.Context, request *slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateLoadBalancerUDPListenerResponse
+	err := retryOnThrottle(ctx, "CreateLoadBalancerUDPListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateLoadBalancerUDPListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetLoadBalancerUDPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "SetLoadBalancerUDPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetLoadBalancerUDPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancerUDPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancerUDPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancerUDPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateLoadBalancerHTTPListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateLoadBalancerHTTPListenerResponse
+	err := retryOnThrottle(ctx, "CreateLoadBalancerHTTPListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateLoadBalancerHTTPListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetLoadBalancerHTTPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "SetLoadBalancerHTTPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetLoadBalancerHTTPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancerHTTPListenerAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancerHTTPListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancerHTTPListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateLoadBalancerHTTPSListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateLoadBalancerHTTPSListenerResponse
+	err := retryOnThrottle(ctx, "CreateLoadBalancerHTTPSListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateLoadBalancerHTTPSListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetLoadBalancerHTTPSListenerAttributeResponse
+	err := retryOnThrottle(ctx, "SetLoadBalancerHTTPSListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetLoadBalancerHTTPSListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancerHTTPSListenerAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancerHTTPSListenerAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancerHTTPSListenerAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StartLoadBalancerListener(ctx context.Context, request *slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.StartLoadBalancerListenerResponse
+	err := retryOnThrottle(ctx, "StartLoadBalancerListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.StartLoadBalancerListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) StopLoadBalancerListener(ctx context.Context, request *slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.StopLoadBalancerListenerResponse
+	err := retryOnThrottle(ctx, "StopLoadBalancerListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.StopLoadBalancerListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteLoadBalancerListener(ctx context.Context, request *slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DeleteLoadBalancerListenerResponse
+	err := retryOnThrottle(ctx, "DeleteLoadBalancerListener", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DeleteLoadBalancerListener(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeLoadBalancerListeners(ctx context.Context, request *slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeLoadBalancerListenersResponse
+	err := retryOnThrottle(ctx, "DescribeLoadBalancerListeners", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeLoadBalancerListeners(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AddBackendServers(ctx context.Context, request *slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.AddBackendServersResponse
+	err := retryOnThrottle(ctx, "AddBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.AddBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RemoveBackendServers(ctx context.Context, request *slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.RemoveBackendServersResponse
+	err := retryOnThrottle(ctx, "RemoveBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.RemoveBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetBackendServers(ctx context.Context, request *slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetBackendServersResponse
+	err := retryOnThrottle(ctx, "SetBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeHealthStatus(ctx context.Context, request *slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeHealthStatusResponse
+	err := retryOnThrottle(ctx, "DescribeHealthStatus", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeHealthStatus(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) CreateVServerGroup(ctx context.Context, request *slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.CreateVServerGroupResponse
+	err := retryOnThrottle(ctx, "CreateVServerGroup", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.CreateVServerGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) SetVServerGroupAttribute(ctx context.Context, request *slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.SetVServerGroupAttributeResponse
+	err := retryOnThrottle(ctx, "SetVServerGroupAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.SetVServerGroupAttribute(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) AddVServerGroupBackendServers(ctx context.Context, request *slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.AddVServerGroupBackendServersResponse
+	err := retryOnThrottle(ctx, "AddVServerGroupBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.AddVServerGroupBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) RemoveVServerGroupBackendServers(ctx context.Context, request *slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.RemoveVServerGroupBackendServersResponse
+	err := retryOnThrottle(ctx, "RemoveVServerGroupBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.RemoveVServerGroupBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) ModifyVServerGroupBackendServers(ctx context.Context, request *slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.ModifyVServerGroupBackendServersResponse
+	err := retryOnThrottle(ctx, "ModifyVServerGroupBackendServers", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.ModifyVServerGroupBackendServers(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DeleteVServerGroup(ctx context.Context, request *slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DeleteVServerGroupResponse
+	err := retryOnThrottle(ctx, "DeleteVServerGroup", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DeleteVServerGroup(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeVServerGroups(ctx context.Context, request *slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeVServerGroupsResponse
+	err := retryOnThrottle(ctx, "DescribeVServerGroups", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeVServerGroups(request)
+		return err
+	})
+	return response, err
+}
+
+func (client *alibabacloudClient) DescribeVServerGroupAttribute(ctx context.Context, request *slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error) {
+	applyEndpointOverride(request, client.slbEndpoint)
+	var response *slb.DescribeVServerGroupAttributeResponse
+	err := retryOnThrottle(ctx, "DescribeVServerGroupAttribute", client.slbLimiter, func() error {
+		var err error
+		response, err = client.slbClient.DescribeVServerGroupAttribute(request)
+		return err
+	})
+	return response, err
 }
 
 // NewClient creates our client wrapper object for the actual alibabacloud clients we use.
 func NewClient(ctrlRuntimeClient client.Client, secretName, namespace, regionID string, configManagedClient client.Client) (Client, error) {
-	credential, err := getCredentialFromSecret(ctrlRuntimeClient, secretName, namespace, configManagedClient)
+	cacheKey, credential, err := resolveCredential(ctrlRuntimeClient, secretName, namespace, regionID, configManagedClient)
 	if err != nil {
 		return nil, err
 	}
 
+	if cached, ok := getCachedClient(cacheKey); ok {
+		return cached, nil
+	}
+
+	transportConfig := getSDKTransportConfig()
 	sdkConfig := &sdk.Config{
-		UserAgent: machineProviderUserAgent,
-		Scheme:    "HTTPS",
+		UserAgent:     machineProviderUserAgent,
+		Scheme:        "HTTPS",
+		HttpTransport: newSDKHTTPTransport(transportConfig),
 	}
 	//init ecsClient
 	ecsClient, err := ecs.NewClientWithOptions(regionID, sdkConfig, credential)
@@ -591,6 +1596,8 @@ func NewClient(ctrlRuntimeClient client.Client, secretName, namespace, regionID
 		klog.Errorf("failed to init ecs client %v", err)
 		return nil, err
 	}
+	ecsClient.SetConnectTimeout(transportConfig.ConnectTimeout)
+	ecsClient.SetReadTimeout(transportConfig.ReadTimeout)
 
 	//init vpcClient
 	vpcClient, err := vpc.NewClientWithOptions(regionID, sdkConfig, credential)
@@ -598,6 +1605,8 @@ func NewClient(ctrlRuntimeClient client.Client, secretName, namespace, regionID
 		klog.Errorf("failed to init vpc client %v", err)
 		return nil, err
 	}
+	vpcClient.SetConnectTimeout(transportConfig.ConnectTimeout)
+	vpcClient.SetReadTimeout(transportConfig.ReadTimeout)
 
 	//init slbClient
 	slbClient, err := slb.NewClientWithOptions(regionID, sdkConfig, credential)
@@ -605,31 +1614,88 @@ func NewClient(ctrlRuntimeClient client.Client, secretName, namespace, regionID
 		klog.Errorf("failed to init slb client %v", err)
 		return nil, err
 	}
+	slbClient.SetConnectTimeout(transportConfig.ConnectTimeout)
+	slbClient.SetReadTimeout(transportConfig.ReadTimeout)
+
+	endpointOverrides := getEndpointOverrides()
+	ecsEndpoint, vpcEndpoint, slbEndpoint := endpointOverrides.ECS, endpointOverrides.VPC, endpointOverrides.SLB
+	if endpointOverrides.AutoResolveRegional {
+		if ecsEndpoint == "" {
+			ecsEndpoint = buildRegionalEndpoint("ecs", regionID)
+		}
+		if vpcEndpoint == "" {
+			vpcEndpoint = buildRegionalEndpoint("vpc", regionID)
+		}
+		if slbEndpoint == "" {
+			slbEndpoint = buildRegionalEndpoint("slb", regionID)
+		}
+	}
 
-	return &alibabacloudClient{
+	newClient := &alibabacloudClient{
 		ecsClient: ecsClient,
 		vpcClient: vpcClient,
 		slbClient: slbClient,
-	}, nil
+
+		ecsLimiter: newAPIRateLimiter(),
+		vpcLimiter: newAPIRateLimiter(),
+		slbLimiter: newAPIRateLimiter(),
+
+		ecsEndpoint: ecsEndpoint,
+		vpcEndpoint: vpcEndpoint,
+		slbEndpoint: slbEndpoint,
+	}
+	setCachedClient(cacheKey, newClient)
+	return newClient, nil
 }
 
-//Init alibabacloud configuration
-//https://github.com/aliyun/alibaba-cloud-sdk-go/blob/master/sdk/auth/credentials/providers/configuration.go
-func getCredentialFromSecret(ctrlRuntimeClient client.Client, secretName, namespace string, configManagedClient client.Client) (auth.Credential, error) {
+// resolveCredential resolves the auth.Credential NewClient should use, along with the
+// clientCacheKey identifying it and regionID, so an unchanged (credentials, region) pair can be
+// served from the client cache without rebuilding the underlying SDK clients.
+// https://github.com/aliyun/alibaba-cloud-sdk-go/blob/master/sdk/auth/credentials/providers/configuration.go
+func resolveCredential(ctrlRuntimeClient client.Client, secretName, namespace, regionID string, configManagedClient client.Client) (clientCacheKey, auth.Credential, error) {
 	if secretName == "" {
-		return nil, fmt.Errorf("secret name is empty")
+		roleName := getAmbientRAMRoleName()
+		if roleName == "" {
+			return clientCacheKey{}, nil, fmt.Errorf("secret name is empty")
+		}
+		// EcsRamRoleCredential fetches and automatically rotates session credentials from the ECS
+		// instance metadata service itself, so no static secret - and no cache invalidation on
+		// rotation - is needed at all.
+		return clientCacheKey{ambientRAMRoleName: roleName, regionID: regionID}, credentials.NewEcsRamRoleCredential(roleName), nil
 	}
 	var secret corev1.Secret
 	if err := ctrlRuntimeClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
 		if apimachineryerrors.IsNotFound(err) {
-			return nil, machineapiapierrors.InvalidMachineConfiguration("alibabacloud credentials secret %s/%s: %v not found", namespace, secretName, err)
+			return clientCacheKey{}, nil, machineapiapierrors.InvalidMachineConfiguration("alibabacloud credentials secret %s/%s: %v not found", namespace, secretName, err)
 		}
-		return nil, err
+		return clientCacheKey{}, nil, err
 	}
-	return fetchCredentialsIniFromSecret(&secret)
+	credential, err := fetchCredentialsIniFromSecret(&secret)
+	if err != nil {
+		return clientCacheKey{}, nil, err
+	}
+	cacheKey := clientCacheKey{
+		namespace:             namespace,
+		secretName:            secretName,
+		secretResourceVersion: secret.ResourceVersion,
+		regionID:              regionID,
+	}
+	return cacheKey, credential, nil
 }
 
 func fetchCredentialsIniFromSecret(secret *corev1.Secret) (auth.Credential, error) {
+	// Short-lived credentials minted by an external system (e.g. an STS broker) are rotated too
+	// often to round-trip through an INI file on disk, so they're read directly from the secret's
+	// own keys instead of going through the 'credentials' INI blob below.
+	if stsToken, ok := secret.Data[kubeAccessKeyStsToken]; ok {
+		accessKeyID, idOk := secret.Data[kubeAccessKeyID]
+		accessKeySecret, secretOk := secret.Data[kubeAccessKeySecret]
+		if !idOk || !secretOk {
+			return nil, fmt.Errorf("failed to fetch keys '%s' and '%s' in secret data alongside '%s'", kubeAccessKeyID, kubeAccessKeySecret, kubeAccessKeyStsToken)
+		}
+		return credentials.NewStsTokenCredential(string(accessKeyID), string(accessKeySecret), string(stsToken)), nil
+	}
+
 	creds, ok := secret.Data[kubeCredentialCredentials]
 	if !ok {
 		return nil, fmt.Errorf("failed to fetch key 'credentials' in secret data")
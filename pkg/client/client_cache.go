@@ -0,0 +1,76 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import "sync"
+
+// clientCacheKey identifies the credentials and region an alibabacloudClient was built for, so
+// NewClient can reuse it instead of paying for fresh SDK clients (and, for secret-backed
+// credentials, a fresh token) on every reconcile. secretResourceVersion is part of the key so that
+// rotating a credentials secret's content - the common case for short-lived STS tokens - naturally
+// busts the cache instead of reusing stale credentials; it's left empty for ambient, RAM-role-based
+// credentials, which refresh themselves behind NewClient's back.
+type clientCacheKey struct {
+	namespace             string
+	secretName            string
+	secretResourceVersion string
+	ambientRAMRoleName    string
+	regionID              string
+}
+
+// clientCacheIdentity is the part of a clientCacheKey that identifies *which* credentials a
+// cached client is for, ignoring secretResourceVersion. It's used to evict the old entry for the
+// same credentials before a rotation inserts a new one, so the cache can't grow without bound as
+// a long-lived secret's ResourceVersion keeps changing over the life of the process.
+type clientCacheIdentity struct {
+	namespace          string
+	secretName         string
+	ambientRAMRoleName string
+	regionID           string
+}
+
+func (k clientCacheKey) identity() clientCacheIdentity {
+	return clientCacheIdentity{
+		namespace:          k.namespace,
+		secretName:         k.secretName,
+		ambientRAMRoleName: k.ambientRAMRoleName,
+		regionID:           k.regionID,
+	}
+}
+
+var (
+	clientCacheMutex sync.Mutex
+	clientCache      = map[clientCacheKey]Client{}
+)
+
+func getCachedClient(key clientCacheKey) (Client, bool) {
+	clientCacheMutex.Lock()
+	defer clientCacheMutex.Unlock()
+	cached, ok := clientCache[key]
+	return cached, ok
+}
+
+func setCachedClient(key clientCacheKey, c Client) {
+	clientCacheMutex.Lock()
+	defer clientCacheMutex.Unlock()
+
+	identity := key.identity()
+	for existingKey := range clientCache {
+		if existingKey != key && existingKey.identity() == identity {
+			delete(clientCache, existingKey)
+		}
+	}
+
+	clientCache[key] = c
+}
@@ -0,0 +1,50 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// credentialsValid tracks the outcome of the most recent ValidateCredentials call for a region, so
+// invalid or expired credentials show up as a dashboard/alert instead of only surfacing as
+// whatever error the first real API call during a reconcile happens to hit.
+var credentialsValid = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "alibabacloud_credentials_valid",
+		Help: "1 if the last credentials preflight check succeeded for the region, 0 if it failed.",
+	}, []string{"region"},
+)
+
+func init() {
+	prometheus.MustRegister(credentialsValid)
+}
+
+// ValidateCredentials calls the cheap, read-only DescribeRegions API to confirm client's
+// credentials are accepted before they're used for the real work of a reconcile, so a rejected or
+// expired STS token is reported as a clear, actionable error up front instead of failing opaquely
+// partway through provisioning a machine.
+func ValidateCredentials(ctx context.Context, client Client, regionID string) error {
+	_, err := client.DescribeRegions(ctx, ecs.CreateDescribeRegionsRequest())
+	if err != nil {
+		credentialsValid.WithLabelValues(regionID).Set(0)
+		return fmt.Errorf("alibaba cloud credentials were rejected calling DescribeRegions: %w", err)
+	}
+	credentialsValid.WithLabelValues(regionID).Set(1)
+	return nil
+}
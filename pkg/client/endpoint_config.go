@@ -0,0 +1,76 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"sync"
+)
+
+// regionalEndpointDomainSuffix is the domain every Alibaba Cloud region, including Gov/Finance
+// cloud sovereign regions, publishes its per-service OpenAPI endpoints under.
+const regionalEndpointDomainSuffix = "aliyuncs.com"
+
+// EndpointOverrides lets callers bypass the SDK's default public endpoint resolution (location
+// service lookup by region) for one or more services, for clusters using VPC endpoints or Alibaba
+// Gov/Finance cloud domains. Only the services vendored in this tree (ecs, vpc, slb) can be
+// overridden; there is no resourcemanager client here to override.
+type EndpointOverrides struct {
+	// ECS overrides the domain used for ECS API calls (instances, disks, images, security
+	// groups), e.g. "ecs-vpc.cn-hangzhou.aliyuncs.com".
+	ECS string
+
+	// VPC overrides the domain used for VPC API calls (VPCs, vswitches, NAT gateways, EIPs).
+	VPC string
+
+	// SLB overrides the domain used for SLB API calls (load balancers, listeners, vserver
+	// groups).
+	SLB string
+
+	// AutoResolveRegional, when true, builds the endpoint for any of ECS/VPC/SLB that is left
+	// empty above directly from the client's region (e.g. "ecs.cn-hangzhou.aliyuncs.com") instead
+	// of letting the SDK query its location service to discover it. The location service itself
+	// is reachable over the public internet, which isn't true from every Gov/Finance cloud
+	// sovereign region, so clients there fail with DNS errors before ever reaching the service
+	// they actually asked for.
+	AutoResolveRegional bool
+}
+
+// buildRegionalEndpoint returns the standard per-region endpoint Alibaba Cloud publishes for
+// serviceCode in regionID, e.g. buildRegionalEndpoint("ecs", "cn-hangzhou") ==
+// "ecs.cn-hangzhou.aliyuncs.com".
+func buildRegionalEndpoint(serviceCode, regionID string) string {
+	return fmt.Sprintf("%s.%s.%s", serviceCode, regionID, regionalEndpointDomainSuffix)
+}
+
+var (
+	endpointOverridesMutex sync.RWMutex
+	endpointOverrides      EndpointOverrides
+)
+
+// SetEndpointOverrides overrides the per-service endpoints used by subsequently created clients.
+// It must be called, if at all, before any AlibabaCloudClientBuilderFunc is invoked, since it has
+// no effect on clients that already exist.
+func SetEndpointOverrides(overrides EndpointOverrides) {
+	endpointOverridesMutex.Lock()
+	defer endpointOverridesMutex.Unlock()
+	endpointOverrides = overrides
+}
+
+// getEndpointOverrides returns the per-service endpoint overrides NewClient should use.
+func getEndpointOverrides() EndpointOverrides {
+	endpointOverridesMutex.RLock()
+	defer endpointOverridesMutex.RUnlock()
+	return endpointOverrides
+}
@@ -0,0 +1,112 @@
+// Package errors classifies Alibaba Cloud SDK server error codes into a small set of typed
+// categories, so callers can branch on the kind of failure instead of pattern-matching on raw
+// error code strings scattered across the actuator.
+package errors
+
+import (
+	"errors"
+	"strings"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+)
+
+// Category is a coarse classification of an Alibaba Cloud SDK server error, grouping together the
+// many vendor-specific error codes that should be handled the same way by a caller.
+type Category string
+
+const (
+	// CategoryThrottling means the request was rejected due to API rate limiting and should be
+	// retried after a backoff.
+	CategoryThrottling Category = "Throttling"
+
+	// CategoryNotFound means the referenced resource does not exist.
+	CategoryNotFound Category = "NotFound"
+
+	// CategoryPermission means the caller's credentials are not authorized to perform the request.
+	CategoryPermission Category = "Permission"
+
+	// CategoryStock means the requested instance type or resource has no available stock in the
+	// target zone and the caller should retry later or fall back to another configuration.
+	CategoryStock Category = "Stock"
+
+	// CategoryQuota means an account or resource quota was exceeded and the request will keep
+	// failing until the quota is raised or usage is reduced.
+	CategoryQuota Category = "Quota"
+
+	// CategoryInvalid means the request itself was malformed or refers to an unsupported
+	// configuration, and retrying without changing the request will not help.
+	CategoryInvalid Category = "Invalid"
+
+	// CategoryUnknown is returned for error codes this package does not recognize.
+	CategoryUnknown Category = "Unknown"
+)
+
+// codePrefixes maps a Category to the error code prefixes that classify into it. Prefixes are
+// matched with strings.HasPrefix, so both exact codes (e.g. "OperationDenied.NoStock") and code
+// families (e.g. "QuotaExceed.") can be listed.
+var codePrefixes = map[Category][]string{
+	CategoryThrottling: {
+		"Throttling",
+		"RequestLimitExceeded",
+		"ServiceUnavailable",
+	},
+	CategoryNotFound: {
+		"InvalidInstanceId.NotFound",
+		"InvalidRegionId.NotFound",
+		"InvalidSecurityGroupId.NotFound",
+		"InvalidVSwitchId.NotFound",
+		"InvalidImageId.NotFound",
+	},
+	CategoryPermission: {
+		"Forbidden",
+		"InvalidAccessKeyId",
+		"SignatureDoesNotMatch",
+	},
+	CategoryStock: {
+		"OperationDenied.NoStock",
+	},
+	CategoryQuota: {
+		"QuotaExceed.",
+	},
+	CategoryInvalid: {
+		"InvalidInstanceType.NotSupported",
+	},
+}
+
+// ClassifyCode maps an Alibaba Cloud SDK error code to its Category. It returns CategoryUnknown
+// for codes with no known mapping.
+func ClassifyCode(code string) Category {
+	for category, prefixes := range codePrefixes {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(code, prefix) {
+				return category
+			}
+		}
+	}
+	return CategoryUnknown
+}
+
+// Classify extracts the Alibaba Cloud SDK error code from err, if err is or wraps a
+// sdkerrors.ServerError, and classifies it with ClassifyCode. It returns CategoryUnknown for any
+// error that is not a ServerError.
+func Classify(err error) Category {
+	var serverError *sdkerrors.ServerError
+	if !errors.As(err, &serverError) {
+		return CategoryUnknown
+	}
+	return ClassifyCode(serverError.ErrorCode())
+}
+
+// Code returns the raw Alibaba Cloud SDK error code for err, for use as a low-cardinality metric
+// label: "" when err is nil, "unknown" when err is not a ServerError, and the error code
+// otherwise.
+func Code(err error) string {
+	if err == nil {
+		return ""
+	}
+	var serverError *sdkerrors.ServerError
+	if !errors.As(err, &serverError) {
+		return "unknown"
+	}
+	return serverError.ErrorCode()
+}
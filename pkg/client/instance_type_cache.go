@@ -0,0 +1,88 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+// instanceTypeCacheTTL is how long a cached DescribeInstanceTypes result is considered fresh.
+const instanceTypeCacheTTL = 10 * time.Minute
+
+type instanceTypeCacheEntry struct {
+	instanceType *ecs.InstanceType
+	expiresAt    time.Time
+}
+
+// InstanceTypeCache is a TTL-based cache of DescribeInstanceTypes results (vCPU, memory, GPU,
+// architecture, NIC limits), shared by the actuator and the machineset annotation controller to
+// avoid repeated full-catalog API calls for the same region and instance type.
+type InstanceTypeCache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]instanceTypeCacheEntry
+}
+
+// NewInstanceTypeCache creates an InstanceTypeCache whose entries expire after ttl.
+func NewInstanceTypeCache(ttl time.Duration) *InstanceTypeCache {
+	return &InstanceTypeCache{
+		ttl:     ttl,
+		entries: make(map[string]instanceTypeCacheEntry),
+	}
+}
+
+// DefaultInstanceTypeCache is the process-wide cache used by callers that don't need an
+// isolated instance.
+var DefaultInstanceTypeCache = NewInstanceTypeCache(instanceTypeCacheTTL)
+
+// Get returns the ecs.InstanceType for regionID/instanceType, querying DescribeInstanceTypes
+// and populating the cache on a miss or an expired entry.
+func (c *InstanceTypeCache) Get(ctx context.Context, client Client, regionID, instanceType string) (*ecs.InstanceType, error) {
+	key := regionID + "/" + instanceType
+
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	c.mutex.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.instanceType, nil
+	}
+
+	request := ecs.CreateDescribeInstanceTypesRequest()
+	request.Scheme = "https"
+	request.RegionId = regionID
+	instanceTypes := []string{instanceType}
+	request.InstanceTypes = &instanceTypes
+
+	response, err := client.DescribeInstanceTypes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || len(response.InstanceTypes.InstanceType) == 0 {
+		return nil, fmt.Errorf("no instance type found for %s", instanceType)
+	}
+
+	result := response.InstanceTypes.InstanceType[0]
+
+	c.mutex.Lock()
+	c.entries[key] = instanceTypeCacheEntry{instanceType: &result, expiresAt: time.Now().Add(c.ttl)}
+	c.mutex.Unlock()
+
+	return &result, nil
+}
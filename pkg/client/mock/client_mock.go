@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	ecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -37,1486 +38,1666 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 }
 
 // AddBackendServers mocks base method.
-func (m *MockClient) AddBackendServers(arg0 *slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error) {
+func (m *MockClient) AddBackendServers(ctx context.Context, request *slb.AddBackendServersRequest) (*slb.AddBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddBackendServers", arg0)
+	ret := m.ctrl.Call(m, "AddBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.AddBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddBackendServers indicates an expected call of AddBackendServers.
-func (mr *MockClientMockRecorder) AddBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AddBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackendServers", reflect.TypeOf((*MockClient)(nil).AddBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackendServers", reflect.TypeOf((*MockClient)(nil).AddBackendServers), ctx, request)
 }
 
 // AddVServerGroupBackendServers mocks base method.
-func (m *MockClient) AddVServerGroupBackendServers(arg0 *slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error) {
+func (m *MockClient) AddVServerGroupBackendServers(ctx context.Context, request *slb.AddVServerGroupBackendServersRequest) (*slb.AddVServerGroupBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddVServerGroupBackendServers", arg0)
+	ret := m.ctrl.Call(m, "AddVServerGroupBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.AddVServerGroupBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddVServerGroupBackendServers indicates an expected call of AddVServerGroupBackendServers.
-func (mr *MockClientMockRecorder) AddVServerGroupBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AddVServerGroupBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).AddVServerGroupBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).AddVServerGroupBackendServers), ctx, request)
 }
 
 // AllocateEipAddress mocks base method.
-func (m *MockClient) AllocateEipAddress(arg0 *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
+func (m *MockClient) AllocateEipAddress(ctx context.Context, request *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AllocateEipAddress", arg0)
+	ret := m.ctrl.Call(m, "AllocateEipAddress", ctx, request)
 	ret0, _ := ret[0].(*vpc.AllocateEipAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AllocateEipAddress indicates an expected call of AllocateEipAddress.
-func (mr *MockClientMockRecorder) AllocateEipAddress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AllocateEipAddress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateEipAddress", reflect.TypeOf((*MockClient)(nil).AllocateEipAddress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateEipAddress", reflect.TypeOf((*MockClient)(nil).AllocateEipAddress), ctx, request)
 }
 
 // AllocatePublicIPAddress mocks base method.
-func (m *MockClient) AllocatePublicIPAddress(arg0 *ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error) {
+func (m *MockClient) AllocatePublicIPAddress(ctx context.Context, request *ecs.AllocatePublicIpAddressRequest) (*ecs.AllocatePublicIpAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AllocatePublicIPAddress", arg0)
+	ret := m.ctrl.Call(m, "AllocatePublicIPAddress", ctx, request)
 	ret0, _ := ret[0].(*ecs.AllocatePublicIpAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AllocatePublicIPAddress indicates an expected call of AllocatePublicIPAddress.
-func (mr *MockClientMockRecorder) AllocatePublicIPAddress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AllocatePublicIPAddress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocatePublicIPAddress", reflect.TypeOf((*MockClient)(nil).AllocatePublicIPAddress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocatePublicIPAddress", reflect.TypeOf((*MockClient)(nil).AllocatePublicIPAddress), ctx, request)
+}
+
+// ApplyAutoSnapshotPolicy mocks base method.
+func (m *MockClient) ApplyAutoSnapshotPolicy(ctx context.Context, request *ecs.ApplyAutoSnapshotPolicyRequest) (*ecs.ApplyAutoSnapshotPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyAutoSnapshotPolicy", ctx, request)
+	ret0, _ := ret[0].(*ecs.ApplyAutoSnapshotPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyAutoSnapshotPolicy indicates an expected call of ApplyAutoSnapshotPolicy.
+func (mr *MockClientMockRecorder) ApplyAutoSnapshotPolicy(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyAutoSnapshotPolicy", reflect.TypeOf((*MockClient)(nil).ApplyAutoSnapshotPolicy), ctx, request)
 }
 
 // AssociateEipAddress mocks base method.
-func (m *MockClient) AssociateEipAddress(arg0 *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
+func (m *MockClient) AssociateEipAddress(ctx context.Context, request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AssociateEipAddress", arg0)
+	ret := m.ctrl.Call(m, "AssociateEipAddress", ctx, request)
 	ret0, _ := ret[0].(*vpc.AssociateEipAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AssociateEipAddress indicates an expected call of AssociateEipAddress.
-func (mr *MockClientMockRecorder) AssociateEipAddress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AssociateEipAddress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateEipAddress", reflect.TypeOf((*MockClient)(nil).AssociateEipAddress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateEipAddress", reflect.TypeOf((*MockClient)(nil).AssociateEipAddress), ctx, request)
 }
 
 // AttachDisk mocks base method.
-func (m *MockClient) AttachDisk(arg0 *ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error) {
+func (m *MockClient) AttachDisk(ctx context.Context, request *ecs.AttachDiskRequest) (*ecs.AttachDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachDisk", arg0)
+	ret := m.ctrl.Call(m, "AttachDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.AttachDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AttachDisk indicates an expected call of AttachDisk.
-func (mr *MockClientMockRecorder) AttachDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AttachDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDisk", reflect.TypeOf((*MockClient)(nil).AttachDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachDisk", reflect.TypeOf((*MockClient)(nil).AttachDisk), ctx, request)
 }
 
 // AttachInstanceRAMRole mocks base method.
-func (m *MockClient) AttachInstanceRAMRole(arg0 *ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error) {
+func (m *MockClient) AttachInstanceRAMRole(ctx context.Context, request *ecs.AttachInstanceRamRoleRequest) (*ecs.AttachInstanceRamRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AttachInstanceRAMRole", arg0)
+	ret := m.ctrl.Call(m, "AttachInstanceRAMRole", ctx, request)
 	ret0, _ := ret[0].(*ecs.AttachInstanceRamRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AttachInstanceRAMRole indicates an expected call of AttachInstanceRAMRole.
-func (mr *MockClientMockRecorder) AttachInstanceRAMRole(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AttachInstanceRAMRole(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachInstanceRAMRole", reflect.TypeOf((*MockClient)(nil).AttachInstanceRAMRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachInstanceRAMRole", reflect.TypeOf((*MockClient)(nil).AttachInstanceRAMRole), ctx, request)
 }
 
 // AuthorizeSecurityGroup mocks base method.
-func (m *MockClient) AuthorizeSecurityGroup(arg0 *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
+func (m *MockClient) AuthorizeSecurityGroup(ctx context.Context, request *ecs.AuthorizeSecurityGroupRequest) (*ecs.AuthorizeSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthorizeSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.AuthorizeSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthorizeSecurityGroup indicates an expected call of AuthorizeSecurityGroup.
-func (mr *MockClientMockRecorder) AuthorizeSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AuthorizeSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroup", reflect.TypeOf((*MockClient)(nil).AuthorizeSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroup", reflect.TypeOf((*MockClient)(nil).AuthorizeSecurityGroup), ctx, request)
 }
 
 // AuthorizeSecurityGroupEgress mocks base method.
-func (m *MockClient) AuthorizeSecurityGroupEgress(arg0 *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
+func (m *MockClient) AuthorizeSecurityGroupEgress(ctx context.Context, request *ecs.AuthorizeSecurityGroupEgressRequest) (*ecs.AuthorizeSecurityGroupEgressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgress", arg0)
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgress", ctx, request)
 	ret0, _ := ret[0].(*ecs.AuthorizeSecurityGroupEgressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AuthorizeSecurityGroupEgress indicates an expected call of AuthorizeSecurityGroupEgress.
-func (mr *MockClientMockRecorder) AuthorizeSecurityGroupEgress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) AuthorizeSecurityGroupEgress(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockClient)(nil).AuthorizeSecurityGroupEgress), ctx, request)
+}
+
+// CopyImage mocks base method.
+func (m *MockClient) CopyImage(ctx context.Context, request *ecs.CopyImageRequest) (*ecs.CopyImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", ctx, request)
+	ret0, _ := ret[0].(*ecs.CopyImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockClientMockRecorder) CopyImage(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockClient)(nil).AuthorizeSecurityGroupEgress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockClient)(nil).CopyImage), ctx, request)
 }
 
 // CreateDisk mocks base method.
-func (m *MockClient) CreateDisk(arg0 *ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error) {
+func (m *MockClient) CreateDisk(ctx context.Context, request *ecs.CreateDiskRequest) (*ecs.CreateDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDisk", arg0)
+	ret := m.ctrl.Call(m, "CreateDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.CreateDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateDisk indicates an expected call of CreateDisk.
-func (mr *MockClientMockRecorder) CreateDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisk", reflect.TypeOf((*MockClient)(nil).CreateDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDisk", reflect.TypeOf((*MockClient)(nil).CreateDisk), ctx, request)
 }
 
 // CreateInstance mocks base method.
-func (m *MockClient) CreateInstance(arg0 *ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error) {
+func (m *MockClient) CreateInstance(ctx context.Context, request *ecs.CreateInstanceRequest) (*ecs.CreateInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateInstance", arg0)
+	ret := m.ctrl.Call(m, "CreateInstance", ctx, request)
 	ret0, _ := ret[0].(*ecs.CreateInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateInstance indicates an expected call of CreateInstance.
-func (mr *MockClientMockRecorder) CreateInstance(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockClient)(nil).CreateInstance), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstance", reflect.TypeOf((*MockClient)(nil).CreateInstance), ctx, request)
 }
 
 // CreateLoadBalancer mocks base method.
-func (m *MockClient) CreateLoadBalancer(arg0 *slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error) {
+func (m *MockClient) CreateLoadBalancer(ctx context.Context, request *slb.CreateLoadBalancerRequest) (*slb.CreateLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancer", arg0)
+	ret := m.ctrl.Call(m, "CreateLoadBalancer", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancer indicates an expected call of CreateLoadBalancer.
-func (mr *MockClientMockRecorder) CreateLoadBalancer(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateLoadBalancer(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancer), ctx, request)
 }
 
 // CreateLoadBalancerHTTPListener mocks base method.
-func (m *MockClient) CreateLoadBalancerHTTPListener(arg0 *slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error) {
+func (m *MockClient) CreateLoadBalancerHTTPListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPListenerRequest) (*slb.CreateLoadBalancerHTTPListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancerHTTPListener", arg0)
+	ret := m.ctrl.Call(m, "CreateLoadBalancerHTTPListener", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateLoadBalancerHTTPListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancerHTTPListener indicates an expected call of CreateLoadBalancerHTTPListener.
-func (mr *MockClientMockRecorder) CreateLoadBalancerHTTPListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateLoadBalancerHTTPListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerHTTPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerHTTPListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerHTTPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerHTTPListener), ctx, request)
 }
 
 // CreateLoadBalancerHTTPSListener mocks base method.
-func (m *MockClient) CreateLoadBalancerHTTPSListener(arg0 *slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error) {
+func (m *MockClient) CreateLoadBalancerHTTPSListener(ctx context.Context, request *slb.CreateLoadBalancerHTTPSListenerRequest) (*slb.CreateLoadBalancerHTTPSListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancerHTTPSListener", arg0)
+	ret := m.ctrl.Call(m, "CreateLoadBalancerHTTPSListener", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateLoadBalancerHTTPSListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancerHTTPSListener indicates an expected call of CreateLoadBalancerHTTPSListener.
-func (mr *MockClientMockRecorder) CreateLoadBalancerHTTPSListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateLoadBalancerHTTPSListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerHTTPSListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerHTTPSListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerHTTPSListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerHTTPSListener), ctx, request)
 }
 
 // CreateLoadBalancerTCPListener mocks base method.
-func (m *MockClient) CreateLoadBalancerTCPListener(arg0 *slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error) {
+func (m *MockClient) CreateLoadBalancerTCPListener(ctx context.Context, request *slb.CreateLoadBalancerTCPListenerRequest) (*slb.CreateLoadBalancerTCPListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancerTCPListener", arg0)
+	ret := m.ctrl.Call(m, "CreateLoadBalancerTCPListener", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateLoadBalancerTCPListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancerTCPListener indicates an expected call of CreateLoadBalancerTCPListener.
-func (mr *MockClientMockRecorder) CreateLoadBalancerTCPListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateLoadBalancerTCPListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerTCPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerTCPListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerTCPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerTCPListener), ctx, request)
 }
 
 // CreateLoadBalancerUDPListener mocks base method.
-func (m *MockClient) CreateLoadBalancerUDPListener(arg0 *slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error) {
+func (m *MockClient) CreateLoadBalancerUDPListener(ctx context.Context, request *slb.CreateLoadBalancerUDPListenerRequest) (*slb.CreateLoadBalancerUDPListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateLoadBalancerUDPListener", arg0)
+	ret := m.ctrl.Call(m, "CreateLoadBalancerUDPListener", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateLoadBalancerUDPListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateLoadBalancerUDPListener indicates an expected call of CreateLoadBalancerUDPListener.
-func (mr *MockClientMockRecorder) CreateLoadBalancerUDPListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateLoadBalancerUDPListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerUDPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerUDPListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerUDPListener", reflect.TypeOf((*MockClient)(nil).CreateLoadBalancerUDPListener), ctx, request)
 }
 
 // CreateNatGateway mocks base method.
-func (m *MockClient) CreateNatGateway(arg0 *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
+func (m *MockClient) CreateNatGateway(ctx context.Context, request *vpc.CreateNatGatewayRequest) (*vpc.CreateNatGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNatGateway", arg0)
+	ret := m.ctrl.Call(m, "CreateNatGateway", ctx, request)
 	ret0, _ := ret[0].(*vpc.CreateNatGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateNatGateway indicates an expected call of CreateNatGateway.
-func (mr *MockClientMockRecorder) CreateNatGateway(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateNatGateway(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockClient)(nil).CreateNatGateway), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockClient)(nil).CreateNatGateway), ctx, request)
 }
 
 // CreateSecurityGroup mocks base method.
-func (m *MockClient) CreateSecurityGroup(arg0 *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
+func (m *MockClient) CreateSecurityGroup(ctx context.Context, request *ecs.CreateSecurityGroupRequest) (*ecs.CreateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "CreateSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.CreateSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSecurityGroup indicates an expected call of CreateSecurityGroup.
-func (mr *MockClientMockRecorder) CreateSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockClient)(nil).CreateSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockClient)(nil).CreateSecurityGroup), ctx, request)
 }
 
 // CreateVServerGroup mocks base method.
-func (m *MockClient) CreateVServerGroup(arg0 *slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error) {
+func (m *MockClient) CreateVServerGroup(ctx context.Context, request *slb.CreateVServerGroupRequest) (*slb.CreateVServerGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVServerGroup", arg0)
+	ret := m.ctrl.Call(m, "CreateVServerGroup", ctx, request)
 	ret0, _ := ret[0].(*slb.CreateVServerGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVServerGroup indicates an expected call of CreateVServerGroup.
-func (mr *MockClientMockRecorder) CreateVServerGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateVServerGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVServerGroup", reflect.TypeOf((*MockClient)(nil).CreateVServerGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVServerGroup", reflect.TypeOf((*MockClient)(nil).CreateVServerGroup), ctx, request)
 }
 
 // CreateVSwitch mocks base method.
-func (m *MockClient) CreateVSwitch(arg0 *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error) {
+func (m *MockClient) CreateVSwitch(ctx context.Context, request *vpc.CreateVSwitchRequest) (*vpc.CreateVSwitchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVSwitch", arg0)
+	ret := m.ctrl.Call(m, "CreateVSwitch", ctx, request)
 	ret0, _ := ret[0].(*vpc.CreateVSwitchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVSwitch indicates an expected call of CreateVSwitch.
-func (mr *MockClientMockRecorder) CreateVSwitch(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateVSwitch(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVSwitch", reflect.TypeOf((*MockClient)(nil).CreateVSwitch), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVSwitch", reflect.TypeOf((*MockClient)(nil).CreateVSwitch), ctx, request)
 }
 
 // CreateVpc mocks base method.
-func (m *MockClient) CreateVpc(arg0 *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error) {
+func (m *MockClient) CreateVpc(ctx context.Context, request *vpc.CreateVpcRequest) (*vpc.CreateVpcResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVpc", arg0)
+	ret := m.ctrl.Call(m, "CreateVpc", ctx, request)
 	ret0, _ := ret[0].(*vpc.CreateVpcResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVpc indicates an expected call of CreateVpc.
-func (mr *MockClientMockRecorder) CreateVpc(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) CreateVpc(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockClient)(nil).CreateVpc), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockClient)(nil).CreateVpc), ctx, request)
 }
 
 // DeleteDisk mocks base method.
-func (m *MockClient) DeleteDisk(arg0 *ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error) {
+func (m *MockClient) DeleteDisk(ctx context.Context, request *ecs.DeleteDiskRequest) (*ecs.DeleteDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteDisk", arg0)
+	ret := m.ctrl.Call(m, "DeleteDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.DeleteDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteDisk indicates an expected call of DeleteDisk.
-func (mr *MockClientMockRecorder) DeleteDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisk", reflect.TypeOf((*MockClient)(nil).DeleteDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDisk", reflect.TypeOf((*MockClient)(nil).DeleteDisk), ctx, request)
 }
 
 // DeleteInstance mocks base method.
-func (m *MockClient) DeleteInstance(arg0 *ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error) {
+func (m *MockClient) DeleteInstance(ctx context.Context, request *ecs.DeleteInstanceRequest) (*ecs.DeleteInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInstance", arg0)
+	ret := m.ctrl.Call(m, "DeleteInstance", ctx, request)
 	ret0, _ := ret[0].(*ecs.DeleteInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteInstance indicates an expected call of DeleteInstance.
-func (mr *MockClientMockRecorder) DeleteInstance(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockClient)(nil).DeleteInstance), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstance", reflect.TypeOf((*MockClient)(nil).DeleteInstance), ctx, request)
 }
 
 // DeleteInstances mocks base method.
-func (m *MockClient) DeleteInstances(arg0 *ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error) {
+func (m *MockClient) DeleteInstances(ctx context.Context, request *ecs.DeleteInstancesRequest) (*ecs.DeleteInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteInstances", arg0)
+	ret := m.ctrl.Call(m, "DeleteInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.DeleteInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteInstances indicates an expected call of DeleteInstances.
-func (mr *MockClientMockRecorder) DeleteInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstances", reflect.TypeOf((*MockClient)(nil).DeleteInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstances", reflect.TypeOf((*MockClient)(nil).DeleteInstances), ctx, request)
 }
 
 // DeleteLoadBalancer mocks base method.
-func (m *MockClient) DeleteLoadBalancer(arg0 *slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error) {
+func (m *MockClient) DeleteLoadBalancer(ctx context.Context, request *slb.DeleteLoadBalancerRequest) (*slb.DeleteLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLoadBalancer", arg0)
+	ret := m.ctrl.Call(m, "DeleteLoadBalancer", ctx, request)
 	ret0, _ := ret[0].(*slb.DeleteLoadBalancerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLoadBalancer indicates an expected call of DeleteLoadBalancer.
-func (mr *MockClientMockRecorder) DeleteLoadBalancer(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteLoadBalancer(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockClient)(nil).DeleteLoadBalancer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockClient)(nil).DeleteLoadBalancer), ctx, request)
 }
 
 // DeleteLoadBalancerListener mocks base method.
-func (m *MockClient) DeleteLoadBalancerListener(arg0 *slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error) {
+func (m *MockClient) DeleteLoadBalancerListener(ctx context.Context, request *slb.DeleteLoadBalancerListenerRequest) (*slb.DeleteLoadBalancerListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLoadBalancerListener", arg0)
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerListener", ctx, request)
 	ret0, _ := ret[0].(*slb.DeleteLoadBalancerListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteLoadBalancerListener indicates an expected call of DeleteLoadBalancerListener.
-func (mr *MockClientMockRecorder) DeleteLoadBalancerListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteLoadBalancerListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).DeleteLoadBalancerListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).DeleteLoadBalancerListener), ctx, request)
 }
 
 // DeleteNatGateway mocks base method.
-func (m *MockClient) DeleteNatGateway(arg0 *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
+func (m *MockClient) DeleteNatGateway(ctx context.Context, request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteNatGateway", arg0)
+	ret := m.ctrl.Call(m, "DeleteNatGateway", ctx, request)
 	ret0, _ := ret[0].(*vpc.DeleteNatGatewayResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteNatGateway indicates an expected call of DeleteNatGateway.
-func (mr *MockClientMockRecorder) DeleteNatGateway(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteNatGateway(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockClient)(nil).DeleteNatGateway), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockClient)(nil).DeleteNatGateway), ctx, request)
 }
 
 // DeleteSecurityGroup mocks base method.
-func (m *MockClient) DeleteSecurityGroup(arg0 *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
+func (m *MockClient) DeleteSecurityGroup(ctx context.Context, request *ecs.DeleteSecurityGroupRequest) (*ecs.DeleteSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "DeleteSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.DeleteSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteSecurityGroup indicates an expected call of DeleteSecurityGroup.
-func (mr *MockClientMockRecorder) DeleteSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockClient)(nil).DeleteSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockClient)(nil).DeleteSecurityGroup), ctx, request)
 }
 
 // DeleteVServerGroup mocks base method.
-func (m *MockClient) DeleteVServerGroup(arg0 *slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error) {
+func (m *MockClient) DeleteVServerGroup(ctx context.Context, request *slb.DeleteVServerGroupRequest) (*slb.DeleteVServerGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVServerGroup", arg0)
+	ret := m.ctrl.Call(m, "DeleteVServerGroup", ctx, request)
 	ret0, _ := ret[0].(*slb.DeleteVServerGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVServerGroup indicates an expected call of DeleteVServerGroup.
-func (mr *MockClientMockRecorder) DeleteVServerGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteVServerGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVServerGroup", reflect.TypeOf((*MockClient)(nil).DeleteVServerGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVServerGroup", reflect.TypeOf((*MockClient)(nil).DeleteVServerGroup), ctx, request)
 }
 
 // DeleteVSwitch mocks base method.
-func (m *MockClient) DeleteVSwitch(arg0 *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error) {
+func (m *MockClient) DeleteVSwitch(ctx context.Context, request *vpc.DeleteVSwitchRequest) (*vpc.DeleteVSwitchResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVSwitch", arg0)
+	ret := m.ctrl.Call(m, "DeleteVSwitch", ctx, request)
 	ret0, _ := ret[0].(*vpc.DeleteVSwitchResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVSwitch indicates an expected call of DeleteVSwitch.
-func (mr *MockClientMockRecorder) DeleteVSwitch(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteVSwitch(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVSwitch", reflect.TypeOf((*MockClient)(nil).DeleteVSwitch), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVSwitch", reflect.TypeOf((*MockClient)(nil).DeleteVSwitch), ctx, request)
 }
 
 // DeleteVpc mocks base method.
-func (m *MockClient) DeleteVpc(arg0 *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error) {
+func (m *MockClient) DeleteVpc(ctx context.Context, request *vpc.DeleteVpcRequest) (*vpc.DeleteVpcResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteVpc", arg0)
+	ret := m.ctrl.Call(m, "DeleteVpc", ctx, request)
 	ret0, _ := ret[0].(*vpc.DeleteVpcResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteVpc indicates an expected call of DeleteVpc.
-func (mr *MockClientMockRecorder) DeleteVpc(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DeleteVpc(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockClient)(nil).DeleteVpc), ctx, request)
+}
+
+// DescribeAvailableResource mocks base method.
+func (m *MockClient) DescribeAvailableResource(ctx context.Context, request *ecs.DescribeAvailableResourceRequest) (*ecs.DescribeAvailableResourceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAvailableResource", ctx, request)
+	ret0, _ := ret[0].(*ecs.DescribeAvailableResourceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAvailableResource indicates an expected call of DescribeAvailableResource.
+func (mr *MockClientMockRecorder) DescribeAvailableResource(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockClient)(nil).DeleteVpc), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAvailableResource", reflect.TypeOf((*MockClient)(nil).DescribeAvailableResource), ctx, request)
 }
 
 // DescribeDisks mocks base method.
-func (m *MockClient) DescribeDisks(arg0 *ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error) {
+func (m *MockClient) DescribeDisks(ctx context.Context, request *ecs.DescribeDisksRequest) (*ecs.DescribeDisksResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeDisks", arg0)
+	ret := m.ctrl.Call(m, "DescribeDisks", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeDisksResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeDisks indicates an expected call of DescribeDisks.
-func (mr *MockClientMockRecorder) DescribeDisks(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeDisks(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDisks", reflect.TypeOf((*MockClient)(nil).DescribeDisks), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeDisks", reflect.TypeOf((*MockClient)(nil).DescribeDisks), ctx, request)
 }
 
 // DescribeEipAddresses mocks base method.
-func (m *MockClient) DescribeEipAddresses(arg0 *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+func (m *MockClient) DescribeEipAddresses(ctx context.Context, request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeEipAddresses", arg0)
+	ret := m.ctrl.Call(m, "DescribeEipAddresses", ctx, request)
 	ret0, _ := ret[0].(*vpc.DescribeEipAddressesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeEipAddresses indicates an expected call of DescribeEipAddresses.
-func (mr *MockClientMockRecorder) DescribeEipAddresses(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeEipAddresses(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEipAddresses", reflect.TypeOf((*MockClient)(nil).DescribeEipAddresses), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEipAddresses", reflect.TypeOf((*MockClient)(nil).DescribeEipAddresses), ctx, request)
 }
 
 // DescribeHealthStatus mocks base method.
-func (m *MockClient) DescribeHealthStatus(arg0 *slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error) {
+func (m *MockClient) DescribeHealthStatus(ctx context.Context, request *slb.DescribeHealthStatusRequest) (*slb.DescribeHealthStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeHealthStatus", arg0)
+	ret := m.ctrl.Call(m, "DescribeHealthStatus", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeHealthStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeHealthStatus indicates an expected call of DescribeHealthStatus.
-func (mr *MockClientMockRecorder) DescribeHealthStatus(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeHealthStatus(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHealthStatus", reflect.TypeOf((*MockClient)(nil).DescribeHealthStatus), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHealthStatus", reflect.TypeOf((*MockClient)(nil).DescribeHealthStatus), ctx, request)
 }
 
 // DescribeImages mocks base method.
-func (m *MockClient) DescribeImages(arg0 *ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error) {
+func (m *MockClient) DescribeImages(ctx context.Context, request *ecs.DescribeImagesRequest) (*ecs.DescribeImagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeImages", arg0)
+	ret := m.ctrl.Call(m, "DescribeImages", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeImagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeImages indicates an expected call of DescribeImages.
-func (mr *MockClientMockRecorder) DescribeImages(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeImages(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImages", reflect.TypeOf((*MockClient)(nil).DescribeImages), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeImages", reflect.TypeOf((*MockClient)(nil).DescribeImages), ctx, request)
 }
 
 // DescribeInstanceStatus mocks base method.
-func (m *MockClient) DescribeInstanceStatus(arg0 *ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error) {
+func (m *MockClient) DescribeInstanceStatus(ctx context.Context, request *ecs.DescribeInstanceStatusRequest) (*ecs.DescribeInstanceStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeInstanceStatus", arg0)
+	ret := m.ctrl.Call(m, "DescribeInstanceStatus", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeInstanceStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeInstanceStatus indicates an expected call of DescribeInstanceStatus.
-func (mr *MockClientMockRecorder) DescribeInstanceStatus(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeInstanceStatus(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceStatus", reflect.TypeOf((*MockClient)(nil).DescribeInstanceStatus), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceStatus", reflect.TypeOf((*MockClient)(nil).DescribeInstanceStatus), ctx, request)
 }
 
 // DescribeInstanceTypes mocks base method.
-func (m *MockClient) DescribeInstanceTypes(arg0 *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
+func (m *MockClient) DescribeInstanceTypes(ctx context.Context, request *ecs.DescribeInstanceTypesRequest) (*ecs.DescribeInstanceTypesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeInstanceTypes", arg0)
+	ret := m.ctrl.Call(m, "DescribeInstanceTypes", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeInstanceTypesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeInstanceTypes indicates an expected call of DescribeInstanceTypes.
-func (mr *MockClientMockRecorder) DescribeInstanceTypes(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeInstanceTypes(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypes", reflect.TypeOf((*MockClient)(nil).DescribeInstanceTypes), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstanceTypes", reflect.TypeOf((*MockClient)(nil).DescribeInstanceTypes), ctx, request)
 }
 
 // DescribeInstances mocks base method.
-func (m *MockClient) DescribeInstances(arg0 *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
+func (m *MockClient) DescribeInstances(ctx context.Context, request *ecs.DescribeInstancesRequest) (*ecs.DescribeInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeInstances", arg0)
+	ret := m.ctrl.Call(m, "DescribeInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeInstances indicates an expected call of DescribeInstances.
-func (mr *MockClientMockRecorder) DescribeInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstances", reflect.TypeOf((*MockClient)(nil).DescribeInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstances", reflect.TypeOf((*MockClient)(nil).DescribeInstances), ctx, request)
+}
+
+// DescribeInstancesFullStatus mocks base method.
+func (m *MockClient) DescribeInstancesFullStatus(ctx context.Context, request *ecs.DescribeInstancesFullStatusRequest) (*ecs.DescribeInstancesFullStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeInstancesFullStatus", ctx, request)
+	ret0, _ := ret[0].(*ecs.DescribeInstancesFullStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeInstancesFullStatus indicates an expected call of DescribeInstancesFullStatus.
+func (mr *MockClientMockRecorder) DescribeInstancesFullStatus(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeInstancesFullStatus", reflect.TypeOf((*MockClient)(nil).DescribeInstancesFullStatus), ctx, request)
 }
 
 // DescribeLoadBalancerHTTPListenerAttribute mocks base method.
-func (m *MockClient) DescribeLoadBalancerHTTPListenerAttribute(arg0 *slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
+func (m *MockClient) DescribeLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancerHTTPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancerHTTPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancerHTTPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancerHTTPListenerAttribute indicates an expected call of DescribeLoadBalancerHTTPListenerAttribute.
-func (mr *MockClientMockRecorder) DescribeLoadBalancerHTTPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancerHTTPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerHTTPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerHTTPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerHTTPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerHTTPListenerAttribute), ctx, request)
 }
 
 // DescribeLoadBalancerHTTPSListenerAttribute mocks base method.
-func (m *MockClient) DescribeLoadBalancerHTTPSListenerAttribute(arg0 *slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
+func (m *MockClient) DescribeLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerHTTPSListenerAttributeRequest) (*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancerHTTPSListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancerHTTPSListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancerHTTPSListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancerHTTPSListenerAttribute indicates an expected call of DescribeLoadBalancerHTTPSListenerAttribute.
-func (mr *MockClientMockRecorder) DescribeLoadBalancerHTTPSListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancerHTTPSListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerHTTPSListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerHTTPSListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerHTTPSListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerHTTPSListenerAttribute), ctx, request)
 }
 
 // DescribeLoadBalancerListeners mocks base method.
-func (m *MockClient) DescribeLoadBalancerListeners(arg0 *slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error) {
+func (m *MockClient) DescribeLoadBalancerListeners(ctx context.Context, request *slb.DescribeLoadBalancerListenersRequest) (*slb.DescribeLoadBalancerListenersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancerListeners", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancerListeners", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancerListenersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancerListeners indicates an expected call of DescribeLoadBalancerListeners.
-func (mr *MockClientMockRecorder) DescribeLoadBalancerListeners(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancerListeners(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerListeners", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerListeners), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerListeners", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerListeners), ctx, request)
 }
 
 // DescribeLoadBalancerTCPListenerAttribute mocks base method.
-func (m *MockClient) DescribeLoadBalancerTCPListenerAttribute(arg0 *slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
+func (m *MockClient) DescribeLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerTCPListenerAttributeRequest) (*slb.DescribeLoadBalancerTCPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancerTCPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancerTCPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancerTCPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancerTCPListenerAttribute indicates an expected call of DescribeLoadBalancerTCPListenerAttribute.
-func (mr *MockClientMockRecorder) DescribeLoadBalancerTCPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancerTCPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerTCPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerTCPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerTCPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerTCPListenerAttribute), ctx, request)
 }
 
 // DescribeLoadBalancerUDPListenerAttribute mocks base method.
-func (m *MockClient) DescribeLoadBalancerUDPListenerAttribute(arg0 *slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
+func (m *MockClient) DescribeLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.DescribeLoadBalancerUDPListenerAttributeRequest) (*slb.DescribeLoadBalancerUDPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancerUDPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancerUDPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancerUDPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancerUDPListenerAttribute indicates an expected call of DescribeLoadBalancerUDPListenerAttribute.
-func (mr *MockClientMockRecorder) DescribeLoadBalancerUDPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancerUDPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerUDPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerUDPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancerUDPListenerAttribute", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancerUDPListenerAttribute), ctx, request)
 }
 
 // DescribeLoadBalancers mocks base method.
-func (m *MockClient) DescribeLoadBalancers(arg0 *slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error) {
+func (m *MockClient) DescribeLoadBalancers(ctx context.Context, request *slb.DescribeLoadBalancersRequest) (*slb.DescribeLoadBalancersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeLoadBalancers", arg0)
+	ret := m.ctrl.Call(m, "DescribeLoadBalancers", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeLoadBalancersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeLoadBalancers indicates an expected call of DescribeLoadBalancers.
-func (mr *MockClientMockRecorder) DescribeLoadBalancers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeLoadBalancers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancers", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLoadBalancers", reflect.TypeOf((*MockClient)(nil).DescribeLoadBalancers), ctx, request)
 }
 
 // DescribeNatGateways mocks base method.
-func (m *MockClient) DescribeNatGateways(arg0 *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
+func (m *MockClient) DescribeNatGateways(ctx context.Context, request *vpc.DescribeNatGatewaysRequest) (*vpc.DescribeNatGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeNatGateways", arg0)
+	ret := m.ctrl.Call(m, "DescribeNatGateways", ctx, request)
 	ret0, _ := ret[0].(*vpc.DescribeNatGatewaysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeNatGateways indicates an expected call of DescribeNatGateways.
-func (mr *MockClientMockRecorder) DescribeNatGateways(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeNatGateways(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockClient)(nil).DescribeNatGateways), ctx, request)
+}
+
+// DescribeNetworkInterfaces mocks base method.
+func (m *MockClient) DescribeNetworkInterfaces(ctx context.Context, request *ecs.DescribeNetworkInterfacesRequest) (*ecs.DescribeNetworkInterfacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeNetworkInterfaces", ctx, request)
+	ret0, _ := ret[0].(*ecs.DescribeNetworkInterfacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeNetworkInterfaces indicates an expected call of DescribeNetworkInterfaces.
+func (mr *MockClientMockRecorder) DescribeNetworkInterfaces(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNatGateways", reflect.TypeOf((*MockClient)(nil).DescribeNatGateways), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeNetworkInterfaces", reflect.TypeOf((*MockClient)(nil).DescribeNetworkInterfaces), ctx, request)
 }
 
 // DescribeRegions mocks base method.
-func (m *MockClient) DescribeRegions(arg0 *ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error) {
+func (m *MockClient) DescribeRegions(ctx context.Context, request *ecs.DescribeRegionsRequest) (*ecs.DescribeRegionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeRegions", arg0)
+	ret := m.ctrl.Call(m, "DescribeRegions", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeRegionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeRegions indicates an expected call of DescribeRegions.
-func (mr *MockClientMockRecorder) DescribeRegions(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeRegions(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockClient)(nil).DescribeRegions), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRegions", reflect.TypeOf((*MockClient)(nil).DescribeRegions), ctx, request)
 }
 
 // DescribeSecurityGroupAttribute mocks base method.
-func (m *MockClient) DescribeSecurityGroupAttribute(arg0 *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
+func (m *MockClient) DescribeSecurityGroupAttribute(ctx context.Context, request *ecs.DescribeSecurityGroupAttributeRequest) (*ecs.DescribeSecurityGroupAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSecurityGroupAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeSecurityGroupAttribute", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeSecurityGroupAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeSecurityGroupAttribute indicates an expected call of DescribeSecurityGroupAttribute.
-func (mr *MockClientMockRecorder) DescribeSecurityGroupAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeSecurityGroupAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroupAttribute", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroupAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroupAttribute", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroupAttribute), ctx, request)
 }
 
 // DescribeSecurityGroupReferences mocks base method.
-func (m *MockClient) DescribeSecurityGroupReferences(arg0 *ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error) {
+func (m *MockClient) DescribeSecurityGroupReferences(ctx context.Context, request *ecs.DescribeSecurityGroupReferencesRequest) (*ecs.DescribeSecurityGroupReferencesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSecurityGroupReferences", arg0)
+	ret := m.ctrl.Call(m, "DescribeSecurityGroupReferences", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeSecurityGroupReferencesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeSecurityGroupReferences indicates an expected call of DescribeSecurityGroupReferences.
-func (mr *MockClientMockRecorder) DescribeSecurityGroupReferences(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeSecurityGroupReferences(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroupReferences", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroupReferences), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroupReferences", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroupReferences), ctx, request)
 }
 
 // DescribeSecurityGroups mocks base method.
-func (m *MockClient) DescribeSecurityGroups(arg0 *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
+func (m *MockClient) DescribeSecurityGroups(ctx context.Context, request *ecs.DescribeSecurityGroupsRequest) (*ecs.DescribeSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeSecurityGroups", arg0)
+	ret := m.ctrl.Call(m, "DescribeSecurityGroups", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeSecurityGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeSecurityGroups indicates an expected call of DescribeSecurityGroups.
-func (mr *MockClientMockRecorder) DescribeSecurityGroups(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeSecurityGroups(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroups", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroups), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroups", reflect.TypeOf((*MockClient)(nil).DescribeSecurityGroups), ctx, request)
 }
 
 // DescribeUserData mocks base method.
-func (m *MockClient) DescribeUserData(arg0 *ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error) {
+func (m *MockClient) DescribeUserData(ctx context.Context, request *ecs.DescribeUserDataRequest) (*ecs.DescribeUserDataResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeUserData", arg0)
+	ret := m.ctrl.Call(m, "DescribeUserData", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeUserDataResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeUserData indicates an expected call of DescribeUserData.
-func (mr *MockClientMockRecorder) DescribeUserData(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeUserData(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeUserData", reflect.TypeOf((*MockClient)(nil).DescribeUserData), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeUserData", reflect.TypeOf((*MockClient)(nil).DescribeUserData), ctx, request)
 }
 
 // DescribeVServerGroupAttribute mocks base method.
-func (m *MockClient) DescribeVServerGroupAttribute(arg0 *slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error) {
+func (m *MockClient) DescribeVServerGroupAttribute(ctx context.Context, request *slb.DescribeVServerGroupAttributeRequest) (*slb.DescribeVServerGroupAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeVServerGroupAttribute", arg0)
+	ret := m.ctrl.Call(m, "DescribeVServerGroupAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeVServerGroupAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeVServerGroupAttribute indicates an expected call of DescribeVServerGroupAttribute.
-func (mr *MockClientMockRecorder) DescribeVServerGroupAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeVServerGroupAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVServerGroupAttribute", reflect.TypeOf((*MockClient)(nil).DescribeVServerGroupAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVServerGroupAttribute", reflect.TypeOf((*MockClient)(nil).DescribeVServerGroupAttribute), ctx, request)
 }
 
 // DescribeVServerGroups mocks base method.
-func (m *MockClient) DescribeVServerGroups(arg0 *slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error) {
+func (m *MockClient) DescribeVServerGroups(ctx context.Context, request *slb.DescribeVServerGroupsRequest) (*slb.DescribeVServerGroupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeVServerGroups", arg0)
+	ret := m.ctrl.Call(m, "DescribeVServerGroups", ctx, request)
 	ret0, _ := ret[0].(*slb.DescribeVServerGroupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeVServerGroups indicates an expected call of DescribeVServerGroups.
-func (mr *MockClientMockRecorder) DescribeVServerGroups(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeVServerGroups(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVServerGroups", reflect.TypeOf((*MockClient)(nil).DescribeVServerGroups), ctx, request)
+}
+
+// DescribeVSwitchAttributes mocks base method.
+func (m *MockClient) DescribeVSwitchAttributes(ctx context.Context, request *vpc.DescribeVSwitchAttributesRequest) (*vpc.DescribeVSwitchAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVSwitchAttributes", ctx, request)
+	ret0, _ := ret[0].(*vpc.DescribeVSwitchAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVSwitchAttributes indicates an expected call of DescribeVSwitchAttributes.
+func (mr *MockClientMockRecorder) DescribeVSwitchAttributes(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVServerGroups", reflect.TypeOf((*MockClient)(nil).DescribeVServerGroups), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVSwitchAttributes", reflect.TypeOf((*MockClient)(nil).DescribeVSwitchAttributes), ctx, request)
 }
 
 // DescribeVSwitches mocks base method.
-func (m *MockClient) DescribeVSwitches(arg0 *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+func (m *MockClient) DescribeVSwitches(ctx context.Context, request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeVSwitches", arg0)
+	ret := m.ctrl.Call(m, "DescribeVSwitches", ctx, request)
 	ret0, _ := ret[0].(*vpc.DescribeVSwitchesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeVSwitches indicates an expected call of DescribeVSwitches.
-func (mr *MockClientMockRecorder) DescribeVSwitches(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeVSwitches(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVSwitches", reflect.TypeOf((*MockClient)(nil).DescribeVSwitches), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVSwitches", reflect.TypeOf((*MockClient)(nil).DescribeVSwitches), ctx, request)
 }
 
 // DescribeVpcs mocks base method.
-func (m *MockClient) DescribeVpcs(arg0 *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error) {
+func (m *MockClient) DescribeVpcs(ctx context.Context, request *vpc.DescribeVpcsRequest) (*vpc.DescribeVpcsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeVpcs", arg0)
+	ret := m.ctrl.Call(m, "DescribeVpcs", ctx, request)
 	ret0, _ := ret[0].(*vpc.DescribeVpcsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeVpcs indicates an expected call of DescribeVpcs.
-func (mr *MockClientMockRecorder) DescribeVpcs(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeVpcs(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcs", reflect.TypeOf((*MockClient)(nil).DescribeVpcs), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVpcs", reflect.TypeOf((*MockClient)(nil).DescribeVpcs), ctx, request)
 }
 
 // DescribeZones mocks base method.
-func (m *MockClient) DescribeZones(arg0 *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error) {
+func (m *MockClient) DescribeZones(ctx context.Context, request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeZones", arg0)
+	ret := m.ctrl.Call(m, "DescribeZones", ctx, request)
 	ret0, _ := ret[0].(*ecs.DescribeZonesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DescribeZones indicates an expected call of DescribeZones.
-func (mr *MockClientMockRecorder) DescribeZones(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DescribeZones(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeZones", reflect.TypeOf((*MockClient)(nil).DescribeZones), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeZones", reflect.TypeOf((*MockClient)(nil).DescribeZones), ctx, request)
 }
 
 // DetachDisk mocks base method.
-func (m *MockClient) DetachDisk(arg0 *ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error) {
+func (m *MockClient) DetachDisk(ctx context.Context, request *ecs.DetachDiskRequest) (*ecs.DetachDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachDisk", arg0)
+	ret := m.ctrl.Call(m, "DetachDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.DetachDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DetachDisk indicates an expected call of DetachDisk.
-func (mr *MockClientMockRecorder) DetachDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DetachDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDisk", reflect.TypeOf((*MockClient)(nil).DetachDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDisk", reflect.TypeOf((*MockClient)(nil).DetachDisk), ctx, request)
 }
 
 // DetachInstanceRAMRole mocks base method.
-func (m *MockClient) DetachInstanceRAMRole(arg0 *ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error) {
+func (m *MockClient) DetachInstanceRAMRole(ctx context.Context, request *ecs.DetachInstanceRamRoleRequest) (*ecs.DetachInstanceRamRoleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DetachInstanceRAMRole", arg0)
+	ret := m.ctrl.Call(m, "DetachInstanceRAMRole", ctx, request)
 	ret0, _ := ret[0].(*ecs.DetachInstanceRamRoleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DetachInstanceRAMRole indicates an expected call of DetachInstanceRAMRole.
-func (mr *MockClientMockRecorder) DetachInstanceRAMRole(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) DetachInstanceRAMRole(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachInstanceRAMRole", reflect.TypeOf((*MockClient)(nil).DetachInstanceRAMRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachInstanceRAMRole", reflect.TypeOf((*MockClient)(nil).DetachInstanceRAMRole), ctx, request)
+}
+
+// GetInstanceConsoleOutput mocks base method.
+func (m *MockClient) GetInstanceConsoleOutput(ctx context.Context, request *ecs.GetInstanceConsoleOutputRequest) (*ecs.GetInstanceConsoleOutputResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceConsoleOutput", ctx, request)
+	ret0, _ := ret[0].(*ecs.GetInstanceConsoleOutputResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceConsoleOutput indicates an expected call of GetInstanceConsoleOutput.
+func (mr *MockClientMockRecorder) GetInstanceConsoleOutput(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceConsoleOutput", reflect.TypeOf((*MockClient)(nil).GetInstanceConsoleOutput), ctx, request)
+}
+
+// GetInstanceScreenshot mocks base method.
+func (m *MockClient) GetInstanceScreenshot(ctx context.Context, request *ecs.GetInstanceScreenshotRequest) (*ecs.GetInstanceScreenshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceScreenshot", ctx, request)
+	ret0, _ := ret[0].(*ecs.GetInstanceScreenshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceScreenshot indicates an expected call of GetInstanceScreenshot.
+func (mr *MockClientMockRecorder) GetInstanceScreenshot(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceScreenshot", reflect.TypeOf((*MockClient)(nil).GetInstanceScreenshot), ctx, request)
+}
+
+// ImportImage mocks base method.
+func (m *MockClient) ImportImage(ctx context.Context, request *ecs.ImportImageRequest) (*ecs.ImportImageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportImage", ctx, request)
+	ret0, _ := ret[0].(*ecs.ImportImageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportImage indicates an expected call of ImportImage.
+func (mr *MockClientMockRecorder) ImportImage(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportImage", reflect.TypeOf((*MockClient)(nil).ImportImage), ctx, request)
 }
 
 // JoinSecurityGroup mocks base method.
-func (m *MockClient) JoinSecurityGroup(arg0 *ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error) {
+func (m *MockClient) JoinSecurityGroup(ctx context.Context, request *ecs.JoinSecurityGroupRequest) (*ecs.JoinSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "JoinSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "JoinSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.JoinSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // JoinSecurityGroup indicates an expected call of JoinSecurityGroup.
-func (mr *MockClientMockRecorder) JoinSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) JoinSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinSecurityGroup", reflect.TypeOf((*MockClient)(nil).JoinSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinSecurityGroup", reflect.TypeOf((*MockClient)(nil).JoinSecurityGroup), ctx, request)
 }
 
 // LeaveSecurityGroup mocks base method.
-func (m *MockClient) LeaveSecurityGroup(arg0 *ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error) {
+func (m *MockClient) LeaveSecurityGroup(ctx context.Context, request *ecs.LeaveSecurityGroupRequest) (*ecs.LeaveSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LeaveSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "LeaveSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.LeaveSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // LeaveSecurityGroup indicates an expected call of LeaveSecurityGroup.
-func (mr *MockClientMockRecorder) LeaveSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) LeaveSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveSecurityGroup", reflect.TypeOf((*MockClient)(nil).LeaveSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveSecurityGroup", reflect.TypeOf((*MockClient)(nil).LeaveSecurityGroup), ctx, request)
 }
 
 // ListTagResources mocks base method.
-func (m *MockClient) ListTagResources(arg0 *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
+func (m *MockClient) ListTagResources(ctx context.Context, request *ecs.ListTagResourcesRequest) (*ecs.ListTagResourcesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTagResources", arg0)
+	ret := m.ctrl.Call(m, "ListTagResources", ctx, request)
 	ret0, _ := ret[0].(*ecs.ListTagResourcesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTagResources indicates an expected call of ListTagResources.
-func (mr *MockClientMockRecorder) ListTagResources(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ListTagResources(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagResources", reflect.TypeOf((*MockClient)(nil).ListTagResources), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagResources", reflect.TypeOf((*MockClient)(nil).ListTagResources), ctx, request)
 }
 
 // ModifyDiskAttribute mocks base method.
-func (m *MockClient) ModifyDiskAttribute(arg0 *ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error) {
+func (m *MockClient) ModifyDiskAttribute(ctx context.Context, request *ecs.ModifyDiskAttributeRequest) (*ecs.ModifyDiskAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyDiskAttribute", arg0)
+	ret := m.ctrl.Call(m, "ModifyDiskAttribute", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifyDiskAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyDiskAttribute indicates an expected call of ModifyDiskAttribute.
-func (mr *MockClientMockRecorder) ModifyDiskAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyDiskAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskAttribute", reflect.TypeOf((*MockClient)(nil).ModifyDiskAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskAttribute", reflect.TypeOf((*MockClient)(nil).ModifyDiskAttribute), ctx, request)
 }
 
 // ModifyDiskChargeType mocks base method.
-func (m *MockClient) ModifyDiskChargeType(arg0 *ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error) {
+func (m *MockClient) ModifyDiskChargeType(ctx context.Context, request *ecs.ModifyDiskChargeTypeRequest) (*ecs.ModifyDiskChargeTypeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyDiskChargeType", arg0)
+	ret := m.ctrl.Call(m, "ModifyDiskChargeType", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifyDiskChargeTypeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyDiskChargeType indicates an expected call of ModifyDiskChargeType.
-func (mr *MockClientMockRecorder) ModifyDiskChargeType(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyDiskChargeType(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskChargeType", reflect.TypeOf((*MockClient)(nil).ModifyDiskChargeType), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskChargeType", reflect.TypeOf((*MockClient)(nil).ModifyDiskChargeType), ctx, request)
 }
 
 // ModifyDiskSpec mocks base method.
-func (m *MockClient) ModifyDiskSpec(arg0 *ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error) {
+func (m *MockClient) ModifyDiskSpec(ctx context.Context, request *ecs.ModifyDiskSpecRequest) (*ecs.ModifyDiskSpecResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyDiskSpec", arg0)
+	ret := m.ctrl.Call(m, "ModifyDiskSpec", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifyDiskSpecResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyDiskSpec indicates an expected call of ModifyDiskSpec.
-func (mr *MockClientMockRecorder) ModifyDiskSpec(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyDiskSpec(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskSpec", reflect.TypeOf((*MockClient)(nil).ModifyDiskSpec), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyDiskSpec", reflect.TypeOf((*MockClient)(nil).ModifyDiskSpec), ctx, request)
 }
 
 // ModifyEipAddressAttribute mocks base method.
-func (m *MockClient) ModifyEipAddressAttribute(arg0 *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
+func (m *MockClient) ModifyEipAddressAttribute(ctx context.Context, request *vpc.ModifyEipAddressAttributeRequest) (*vpc.ModifyEipAddressAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyEipAddressAttribute", arg0)
+	ret := m.ctrl.Call(m, "ModifyEipAddressAttribute", ctx, request)
 	ret0, _ := ret[0].(*vpc.ModifyEipAddressAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyEipAddressAttribute indicates an expected call of ModifyEipAddressAttribute.
-func (mr *MockClientMockRecorder) ModifyEipAddressAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyEipAddressAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEipAddressAttribute", reflect.TypeOf((*MockClient)(nil).ModifyEipAddressAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEipAddressAttribute", reflect.TypeOf((*MockClient)(nil).ModifyEipAddressAttribute), ctx, request)
 }
 
 // ModifyInstanceAttribute mocks base method.
-func (m *MockClient) ModifyInstanceAttribute(arg0 *ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error) {
+func (m *MockClient) ModifyInstanceAttribute(ctx context.Context, request *ecs.ModifyInstanceAttributeRequest) (*ecs.ModifyInstanceAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyInstanceAttribute", arg0)
+	ret := m.ctrl.Call(m, "ModifyInstanceAttribute", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifyInstanceAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyInstanceAttribute indicates an expected call of ModifyInstanceAttribute.
-func (mr *MockClientMockRecorder) ModifyInstanceAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyInstanceAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceAttribute", reflect.TypeOf((*MockClient)(nil).ModifyInstanceAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceAttribute", reflect.TypeOf((*MockClient)(nil).ModifyInstanceAttribute), ctx, request)
 }
 
 // ModifyInstanceMetadataOptions mocks base method.
-func (m *MockClient) ModifyInstanceMetadataOptions(arg0 *ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error) {
+func (m *MockClient) ModifyInstanceMetadataOptions(ctx context.Context, request *ecs.ModifyInstanceMetadataOptionsRequest) (*ecs.ModifyInstanceMetadataOptionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyInstanceMetadataOptions", arg0)
+	ret := m.ctrl.Call(m, "ModifyInstanceMetadataOptions", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifyInstanceMetadataOptionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyInstanceMetadataOptions indicates an expected call of ModifyInstanceMetadataOptions.
-func (mr *MockClientMockRecorder) ModifyInstanceMetadataOptions(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyInstanceMetadataOptions(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMetadataOptions", reflect.TypeOf((*MockClient)(nil).ModifyInstanceMetadataOptions), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceMetadataOptions", reflect.TypeOf((*MockClient)(nil).ModifyInstanceMetadataOptions), ctx, request)
+}
+
+// ModifyInstanceSpec mocks base method.
+func (m *MockClient) ModifyInstanceSpec(ctx context.Context, request *ecs.ModifyInstanceSpecRequest) (*ecs.ModifyInstanceSpecResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyInstanceSpec", ctx, request)
+	ret0, _ := ret[0].(*ecs.ModifyInstanceSpecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyInstanceSpec indicates an expected call of ModifyInstanceSpec.
+func (mr *MockClientMockRecorder) ModifyInstanceSpec(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyInstanceSpec", reflect.TypeOf((*MockClient)(nil).ModifyInstanceSpec), ctx, request)
+}
+
+// ModifyNetworkInterfaceAttribute mocks base method.
+func (m *MockClient) ModifyNetworkInterfaceAttribute(ctx context.Context, request *ecs.ModifyNetworkInterfaceAttributeRequest) (*ecs.ModifyNetworkInterfaceAttributeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyNetworkInterfaceAttribute", ctx, request)
+	ret0, _ := ret[0].(*ecs.ModifyNetworkInterfaceAttributeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyNetworkInterfaceAttribute indicates an expected call of ModifyNetworkInterfaceAttribute.
+func (mr *MockClientMockRecorder) ModifyNetworkInterfaceAttribute(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyNetworkInterfaceAttribute", reflect.TypeOf((*MockClient)(nil).ModifyNetworkInterfaceAttribute), ctx, request)
 }
 
 // ModifySecurityGroupAttribute mocks base method.
-func (m *MockClient) ModifySecurityGroupAttribute(arg0 *ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error) {
+func (m *MockClient) ModifySecurityGroupAttribute(ctx context.Context, request *ecs.ModifySecurityGroupAttributeRequest) (*ecs.ModifySecurityGroupAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifySecurityGroupAttribute", arg0)
+	ret := m.ctrl.Call(m, "ModifySecurityGroupAttribute", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifySecurityGroupAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifySecurityGroupAttribute indicates an expected call of ModifySecurityGroupAttribute.
-func (mr *MockClientMockRecorder) ModifySecurityGroupAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifySecurityGroupAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupAttribute", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupAttribute", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupAttribute), ctx, request)
 }
 
 // ModifySecurityGroupEgressRule mocks base method.
-func (m *MockClient) ModifySecurityGroupEgressRule(arg0 *ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error) {
+func (m *MockClient) ModifySecurityGroupEgressRule(ctx context.Context, request *ecs.ModifySecurityGroupEgressRuleRequest) (*ecs.ModifySecurityGroupEgressRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifySecurityGroupEgressRule", arg0)
+	ret := m.ctrl.Call(m, "ModifySecurityGroupEgressRule", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifySecurityGroupEgressRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifySecurityGroupEgressRule indicates an expected call of ModifySecurityGroupEgressRule.
-func (mr *MockClientMockRecorder) ModifySecurityGroupEgressRule(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifySecurityGroupEgressRule(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupEgressRule", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupEgressRule), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupEgressRule", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupEgressRule), ctx, request)
 }
 
 // ModifySecurityGroupPolicy mocks base method.
-func (m *MockClient) ModifySecurityGroupPolicy(arg0 *ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error) {
+func (m *MockClient) ModifySecurityGroupPolicy(ctx context.Context, request *ecs.ModifySecurityGroupPolicyRequest) (*ecs.ModifySecurityGroupPolicyResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifySecurityGroupPolicy", arg0)
+	ret := m.ctrl.Call(m, "ModifySecurityGroupPolicy", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifySecurityGroupPolicyResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifySecurityGroupPolicy indicates an expected call of ModifySecurityGroupPolicy.
-func (mr *MockClientMockRecorder) ModifySecurityGroupPolicy(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifySecurityGroupPolicy(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupPolicy", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupPolicy), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupPolicy", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupPolicy), ctx, request)
 }
 
 // ModifySecurityGroupRule mocks base method.
-func (m *MockClient) ModifySecurityGroupRule(arg0 *ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error) {
+func (m *MockClient) ModifySecurityGroupRule(ctx context.Context, request *ecs.ModifySecurityGroupRuleRequest) (*ecs.ModifySecurityGroupRuleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifySecurityGroupRule", arg0)
+	ret := m.ctrl.Call(m, "ModifySecurityGroupRule", ctx, request)
 	ret0, _ := ret[0].(*ecs.ModifySecurityGroupRuleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifySecurityGroupRule indicates an expected call of ModifySecurityGroupRule.
-func (mr *MockClientMockRecorder) ModifySecurityGroupRule(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifySecurityGroupRule(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupRule", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupRule), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifySecurityGroupRule", reflect.TypeOf((*MockClient)(nil).ModifySecurityGroupRule), ctx, request)
 }
 
 // ModifyVServerGroupBackendServers mocks base method.
-func (m *MockClient) ModifyVServerGroupBackendServers(arg0 *slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error) {
+func (m *MockClient) ModifyVServerGroupBackendServers(ctx context.Context, request *slb.ModifyVServerGroupBackendServersRequest) (*slb.ModifyVServerGroupBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ModifyVServerGroupBackendServers", arg0)
+	ret := m.ctrl.Call(m, "ModifyVServerGroupBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.ModifyVServerGroupBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ModifyVServerGroupBackendServers indicates an expected call of ModifyVServerGroupBackendServers.
-func (mr *MockClientMockRecorder) ModifyVServerGroupBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ModifyVServerGroupBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).ModifyVServerGroupBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).ModifyVServerGroupBackendServers), ctx, request)
 }
 
 // ReActivateInstances mocks base method.
-func (m *MockClient) ReActivateInstances(arg0 *ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error) {
+func (m *MockClient) ReActivateInstances(ctx context.Context, request *ecs.ReActivateInstancesRequest) (*ecs.ReActivateInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReActivateInstances", arg0)
+	ret := m.ctrl.Call(m, "ReActivateInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.ReActivateInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReActivateInstances indicates an expected call of ReActivateInstances.
-func (mr *MockClientMockRecorder) ReActivateInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReActivateInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReActivateInstances", reflect.TypeOf((*MockClient)(nil).ReActivateInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReActivateInstances", reflect.TypeOf((*MockClient)(nil).ReActivateInstances), ctx, request)
 }
 
 // ReInitDisk mocks base method.
-func (m *MockClient) ReInitDisk(arg0 *ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error) {
+func (m *MockClient) ReInitDisk(ctx context.Context, request *ecs.ReInitDiskRequest) (*ecs.ReInitDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReInitDisk", arg0)
+	ret := m.ctrl.Call(m, "ReInitDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.ReInitDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReInitDisk indicates an expected call of ReInitDisk.
-func (mr *MockClientMockRecorder) ReInitDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReInitDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReInitDisk", reflect.TypeOf((*MockClient)(nil).ReInitDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReInitDisk", reflect.TypeOf((*MockClient)(nil).ReInitDisk), ctx, request)
 }
 
 // RebootInstance mocks base method.
-func (m *MockClient) RebootInstance(request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error) {
+func (m *MockClient) RebootInstance(ctx context.Context, request *ecs.RebootInstanceRequest) (*ecs.RebootInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebootInstance", request)
+	ret := m.ctrl.Call(m, "RebootInstance", ctx, request)
 	ret0, _ := ret[0].(*ecs.RebootInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RebootInstance indicates an expected call of RebootInstance.
-func (mr *MockClientMockRecorder) RebootInstance(request interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RebootInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootInstance", reflect.TypeOf((*MockClient)(nil).RebootInstance), request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootInstance", reflect.TypeOf((*MockClient)(nil).RebootInstance), ctx, request)
 }
 
 // RebootInstances mocks base method.
-func (m *MockClient) RebootInstances(request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error) {
+func (m *MockClient) RebootInstances(ctx context.Context, request *ecs.RebootInstancesRequest) (*ecs.RebootInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RebootInstances", request)
+	ret := m.ctrl.Call(m, "RebootInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.RebootInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RebootInstances indicates an expected call of RebootInstances.
-func (mr *MockClientMockRecorder) RebootInstances(request interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RebootInstances(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootInstances", reflect.TypeOf((*MockClient)(nil).RebootInstances), ctx, request)
+}
+
+// RedeployInstance mocks base method.
+func (m *MockClient) RedeployInstance(ctx context.Context, request *ecs.RedeployInstanceRequest) (*ecs.RedeployInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedeployInstance", ctx, request)
+	ret0, _ := ret[0].(*ecs.RedeployInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RedeployInstance indicates an expected call of RedeployInstance.
+func (mr *MockClientMockRecorder) RedeployInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootInstances", reflect.TypeOf((*MockClient)(nil).RebootInstances), request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeployInstance", reflect.TypeOf((*MockClient)(nil).RedeployInstance), ctx, request)
 }
 
 // ReleaseEipAddress mocks base method.
-func (m *MockClient) ReleaseEipAddress(arg0 *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
+func (m *MockClient) ReleaseEipAddress(ctx context.Context, request *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReleaseEipAddress", arg0)
+	ret := m.ctrl.Call(m, "ReleaseEipAddress", ctx, request)
 	ret0, _ := ret[0].(*vpc.ReleaseEipAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReleaseEipAddress indicates an expected call of ReleaseEipAddress.
-func (mr *MockClientMockRecorder) ReleaseEipAddress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReleaseEipAddress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEipAddress", reflect.TypeOf((*MockClient)(nil).ReleaseEipAddress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEipAddress", reflect.TypeOf((*MockClient)(nil).ReleaseEipAddress), ctx, request)
 }
 
 // RemoveBackendServers mocks base method.
-func (m *MockClient) RemoveBackendServers(arg0 *slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error) {
+func (m *MockClient) RemoveBackendServers(ctx context.Context, request *slb.RemoveBackendServersRequest) (*slb.RemoveBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveBackendServers", arg0)
+	ret := m.ctrl.Call(m, "RemoveBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.RemoveBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveBackendServers indicates an expected call of RemoveBackendServers.
-func (mr *MockClientMockRecorder) RemoveBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RemoveBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBackendServers", reflect.TypeOf((*MockClient)(nil).RemoveBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBackendServers", reflect.TypeOf((*MockClient)(nil).RemoveBackendServers), ctx, request)
 }
 
 // RemoveVServerGroupBackendServers mocks base method.
-func (m *MockClient) RemoveVServerGroupBackendServers(arg0 *slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error) {
+func (m *MockClient) RemoveVServerGroupBackendServers(ctx context.Context, request *slb.RemoveVServerGroupBackendServersRequest) (*slb.RemoveVServerGroupBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveVServerGroupBackendServers", arg0)
+	ret := m.ctrl.Call(m, "RemoveVServerGroupBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.RemoveVServerGroupBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveVServerGroupBackendServers indicates an expected call of RemoveVServerGroupBackendServers.
-func (mr *MockClientMockRecorder) RemoveVServerGroupBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RemoveVServerGroupBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).RemoveVServerGroupBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVServerGroupBackendServers", reflect.TypeOf((*MockClient)(nil).RemoveVServerGroupBackendServers), ctx, request)
 }
 
 // ReplaceSystemDisk mocks base method.
-func (m *MockClient) ReplaceSystemDisk(arg0 *ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error) {
+func (m *MockClient) ReplaceSystemDisk(ctx context.Context, request *ecs.ReplaceSystemDiskRequest) (*ecs.ReplaceSystemDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReplaceSystemDisk", arg0)
+	ret := m.ctrl.Call(m, "ReplaceSystemDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.ReplaceSystemDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReplaceSystemDisk indicates an expected call of ReplaceSystemDisk.
-func (mr *MockClientMockRecorder) ReplaceSystemDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ReplaceSystemDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSystemDisk", reflect.TypeOf((*MockClient)(nil).ReplaceSystemDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSystemDisk", reflect.TypeOf((*MockClient)(nil).ReplaceSystemDisk), ctx, request)
 }
 
 // ResetDisk mocks base method.
-func (m *MockClient) ResetDisk(arg0 *ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error) {
+func (m *MockClient) ResetDisk(ctx context.Context, request *ecs.ResetDiskRequest) (*ecs.ResetDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetDisk", arg0)
+	ret := m.ctrl.Call(m, "ResetDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.ResetDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResetDisk indicates an expected call of ResetDisk.
-func (mr *MockClientMockRecorder) ResetDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ResetDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDisk", reflect.TypeOf((*MockClient)(nil).ResetDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDisk", reflect.TypeOf((*MockClient)(nil).ResetDisk), ctx, request)
 }
 
 // ResizeDisk mocks base method.
-func (m *MockClient) ResizeDisk(arg0 *ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error) {
+func (m *MockClient) ResizeDisk(ctx context.Context, request *ecs.ResizeDiskRequest) (*ecs.ResizeDiskResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResizeDisk", arg0)
+	ret := m.ctrl.Call(m, "ResizeDisk", ctx, request)
 	ret0, _ := ret[0].(*ecs.ResizeDiskResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ResizeDisk indicates an expected call of ResizeDisk.
-func (mr *MockClientMockRecorder) ResizeDisk(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) ResizeDisk(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockClient)(nil).ResizeDisk), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeDisk", reflect.TypeOf((*MockClient)(nil).ResizeDisk), ctx, request)
 }
 
 // RevokeSecurityGroup mocks base method.
-func (m *MockClient) RevokeSecurityGroup(arg0 *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
+func (m *MockClient) RevokeSecurityGroup(ctx context.Context, request *ecs.RevokeSecurityGroupRequest) (*ecs.RevokeSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSecurityGroup", arg0)
+	ret := m.ctrl.Call(m, "RevokeSecurityGroup", ctx, request)
 	ret0, _ := ret[0].(*ecs.RevokeSecurityGroupResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevokeSecurityGroup indicates an expected call of RevokeSecurityGroup.
-func (mr *MockClientMockRecorder) RevokeSecurityGroup(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RevokeSecurityGroup(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroup", reflect.TypeOf((*MockClient)(nil).RevokeSecurityGroup), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroup", reflect.TypeOf((*MockClient)(nil).RevokeSecurityGroup), ctx, request)
 }
 
 // RevokeSecurityGroupEgress mocks base method.
-func (m *MockClient) RevokeSecurityGroupEgress(arg0 *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error) {
+func (m *MockClient) RevokeSecurityGroupEgress(ctx context.Context, request *ecs.RevokeSecurityGroupEgressRequest) (*ecs.RevokeSecurityGroupEgressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgress", arg0)
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgress", ctx, request)
 	ret0, _ := ret[0].(*ecs.RevokeSecurityGroupEgressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RevokeSecurityGroupEgress indicates an expected call of RevokeSecurityGroupEgress.
-func (mr *MockClientMockRecorder) RevokeSecurityGroupEgress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RevokeSecurityGroupEgress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgress", reflect.TypeOf((*MockClient)(nil).RevokeSecurityGroupEgress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgress", reflect.TypeOf((*MockClient)(nil).RevokeSecurityGroupEgress), ctx, request)
 }
 
 // RunInstances mocks base method.
-func (m *MockClient) RunInstances(arg0 *ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error) {
+func (m *MockClient) RunInstances(ctx context.Context, request *ecs.RunInstancesRequest) (*ecs.RunInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunInstances", arg0)
+	ret := m.ctrl.Call(m, "RunInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.RunInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RunInstances indicates an expected call of RunInstances.
-func (mr *MockClientMockRecorder) RunInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) RunInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInstances", reflect.TypeOf((*MockClient)(nil).RunInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInstances", reflect.TypeOf((*MockClient)(nil).RunInstances), ctx, request)
 }
 
 // SetBackendServers mocks base method.
-func (m *MockClient) SetBackendServers(arg0 *slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error) {
+func (m *MockClient) SetBackendServers(ctx context.Context, request *slb.SetBackendServersRequest) (*slb.SetBackendServersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBackendServers", arg0)
+	ret := m.ctrl.Call(m, "SetBackendServers", ctx, request)
 	ret0, _ := ret[0].(*slb.SetBackendServersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetBackendServers indicates an expected call of SetBackendServers.
-func (mr *MockClientMockRecorder) SetBackendServers(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetBackendServers(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackendServers", reflect.TypeOf((*MockClient)(nil).SetBackendServers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBackendServers", reflect.TypeOf((*MockClient)(nil).SetBackendServers), ctx, request)
 }
 
 // SetLoadBalancerHTTPListenerAttribute mocks base method.
-func (m *MockClient) SetLoadBalancerHTTPListenerAttribute(arg0 *slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error) {
+func (m *MockClient) SetLoadBalancerHTTPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPListenerAttributeRequest) (*slb.SetLoadBalancerHTTPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLoadBalancerHTTPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "SetLoadBalancerHTTPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.SetLoadBalancerHTTPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetLoadBalancerHTTPListenerAttribute indicates an expected call of SetLoadBalancerHTTPListenerAttribute.
-func (mr *MockClientMockRecorder) SetLoadBalancerHTTPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetLoadBalancerHTTPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerHTTPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerHTTPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerHTTPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerHTTPListenerAttribute), ctx, request)
 }
 
 // SetLoadBalancerHTTPSListenerAttribute mocks base method.
-func (m *MockClient) SetLoadBalancerHTTPSListenerAttribute(arg0 *slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error) {
+func (m *MockClient) SetLoadBalancerHTTPSListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerHTTPSListenerAttributeRequest) (*slb.SetLoadBalancerHTTPSListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLoadBalancerHTTPSListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "SetLoadBalancerHTTPSListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.SetLoadBalancerHTTPSListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetLoadBalancerHTTPSListenerAttribute indicates an expected call of SetLoadBalancerHTTPSListenerAttribute.
-func (mr *MockClientMockRecorder) SetLoadBalancerHTTPSListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetLoadBalancerHTTPSListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerHTTPSListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerHTTPSListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerHTTPSListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerHTTPSListenerAttribute), ctx, request)
 }
 
 // SetLoadBalancerTCPListenerAttribute mocks base method.
-func (m *MockClient) SetLoadBalancerTCPListenerAttribute(arg0 *slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error) {
+func (m *MockClient) SetLoadBalancerTCPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerTCPListenerAttributeRequest) (*slb.SetLoadBalancerTCPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLoadBalancerTCPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "SetLoadBalancerTCPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.SetLoadBalancerTCPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetLoadBalancerTCPListenerAttribute indicates an expected call of SetLoadBalancerTCPListenerAttribute.
-func (mr *MockClientMockRecorder) SetLoadBalancerTCPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetLoadBalancerTCPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerTCPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerTCPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerTCPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerTCPListenerAttribute), ctx, request)
 }
 
 // SetLoadBalancerUDPListenerAttribute mocks base method.
-func (m *MockClient) SetLoadBalancerUDPListenerAttribute(arg0 *slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error) {
+func (m *MockClient) SetLoadBalancerUDPListenerAttribute(ctx context.Context, request *slb.SetLoadBalancerUDPListenerAttributeRequest) (*slb.SetLoadBalancerUDPListenerAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetLoadBalancerUDPListenerAttribute", arg0)
+	ret := m.ctrl.Call(m, "SetLoadBalancerUDPListenerAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.SetLoadBalancerUDPListenerAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetLoadBalancerUDPListenerAttribute indicates an expected call of SetLoadBalancerUDPListenerAttribute.
-func (mr *MockClientMockRecorder) SetLoadBalancerUDPListenerAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetLoadBalancerUDPListenerAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerUDPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerUDPListenerAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLoadBalancerUDPListenerAttribute", reflect.TypeOf((*MockClient)(nil).SetLoadBalancerUDPListenerAttribute), ctx, request)
 }
 
 // SetVServerGroupAttribute mocks base method.
-func (m *MockClient) SetVServerGroupAttribute(arg0 *slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error) {
+func (m *MockClient) SetVServerGroupAttribute(ctx context.Context, request *slb.SetVServerGroupAttributeRequest) (*slb.SetVServerGroupAttributeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetVServerGroupAttribute", arg0)
+	ret := m.ctrl.Call(m, "SetVServerGroupAttribute", ctx, request)
 	ret0, _ := ret[0].(*slb.SetVServerGroupAttributeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetVServerGroupAttribute indicates an expected call of SetVServerGroupAttribute.
-func (mr *MockClientMockRecorder) SetVServerGroupAttribute(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) SetVServerGroupAttribute(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVServerGroupAttribute", reflect.TypeOf((*MockClient)(nil).SetVServerGroupAttribute), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVServerGroupAttribute", reflect.TypeOf((*MockClient)(nil).SetVServerGroupAttribute), ctx, request)
 }
 
 // StartInstance mocks base method.
-func (m *MockClient) StartInstance(arg0 *ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error) {
+func (m *MockClient) StartInstance(ctx context.Context, request *ecs.StartInstanceRequest) (*ecs.StartInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartInstance", arg0)
+	ret := m.ctrl.Call(m, "StartInstance", ctx, request)
 	ret0, _ := ret[0].(*ecs.StartInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartInstance indicates an expected call of StartInstance.
-func (mr *MockClientMockRecorder) StartInstance(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StartInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstance", reflect.TypeOf((*MockClient)(nil).StartInstance), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstance", reflect.TypeOf((*MockClient)(nil).StartInstance), ctx, request)
 }
 
 // StartInstances mocks base method.
-func (m *MockClient) StartInstances(arg0 *ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error) {
+func (m *MockClient) StartInstances(ctx context.Context, request *ecs.StartInstancesRequest) (*ecs.StartInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartInstances", arg0)
+	ret := m.ctrl.Call(m, "StartInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.StartInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartInstances indicates an expected call of StartInstances.
-func (mr *MockClientMockRecorder) StartInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StartInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstances", reflect.TypeOf((*MockClient)(nil).StartInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInstances", reflect.TypeOf((*MockClient)(nil).StartInstances), ctx, request)
 }
 
 // StartLoadBalancerListener mocks base method.
-func (m *MockClient) StartLoadBalancerListener(arg0 *slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error) {
+func (m *MockClient) StartLoadBalancerListener(ctx context.Context, request *slb.StartLoadBalancerListenerRequest) (*slb.StartLoadBalancerListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StartLoadBalancerListener", arg0)
+	ret := m.ctrl.Call(m, "StartLoadBalancerListener", ctx, request)
 	ret0, _ := ret[0].(*slb.StartLoadBalancerListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StartLoadBalancerListener indicates an expected call of StartLoadBalancerListener.
-func (mr *MockClientMockRecorder) StartLoadBalancerListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StartLoadBalancerListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).StartLoadBalancerListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).StartLoadBalancerListener), ctx, request)
 }
 
 // StopInstance mocks base method.
-func (m *MockClient) StopInstance(arg0 *ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error) {
+func (m *MockClient) StopInstance(ctx context.Context, request *ecs.StopInstanceRequest) (*ecs.StopInstanceResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopInstance", arg0)
+	ret := m.ctrl.Call(m, "StopInstance", ctx, request)
 	ret0, _ := ret[0].(*ecs.StopInstanceResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopInstance indicates an expected call of StopInstance.
-func (mr *MockClientMockRecorder) StopInstance(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StopInstance(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstance", reflect.TypeOf((*MockClient)(nil).StopInstance), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstance", reflect.TypeOf((*MockClient)(nil).StopInstance), ctx, request)
 }
 
 // StopInstances mocks base method.
-func (m *MockClient) StopInstances(arg0 *ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error) {
+func (m *MockClient) StopInstances(ctx context.Context, request *ecs.StopInstancesRequest) (*ecs.StopInstancesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopInstances", arg0)
+	ret := m.ctrl.Call(m, "StopInstances", ctx, request)
 	ret0, _ := ret[0].(*ecs.StopInstancesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopInstances indicates an expected call of StopInstances.
-func (mr *MockClientMockRecorder) StopInstances(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StopInstances(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstances", reflect.TypeOf((*MockClient)(nil).StopInstances), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInstances", reflect.TypeOf((*MockClient)(nil).StopInstances), ctx, request)
 }
 
 // StopLoadBalancerListener mocks base method.
-func (m *MockClient) StopLoadBalancerListener(arg0 *slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error) {
+func (m *MockClient) StopLoadBalancerListener(ctx context.Context, request *slb.StopLoadBalancerListenerRequest) (*slb.StopLoadBalancerListenerResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopLoadBalancerListener", arg0)
+	ret := m.ctrl.Call(m, "StopLoadBalancerListener", ctx, request)
 	ret0, _ := ret[0].(*slb.StopLoadBalancerListenerResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // StopLoadBalancerListener indicates an expected call of StopLoadBalancerListener.
-func (mr *MockClientMockRecorder) StopLoadBalancerListener(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) StopLoadBalancerListener(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).StopLoadBalancerListener), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopLoadBalancerListener", reflect.TypeOf((*MockClient)(nil).StopLoadBalancerListener), ctx, request)
 }
 
 // TagResources mocks base method.
-func (m *MockClient) TagResources(arg0 *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
+func (m *MockClient) TagResources(ctx context.Context, request *ecs.TagResourcesRequest) (*ecs.TagResourcesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TagResources", arg0)
+	ret := m.ctrl.Call(m, "TagResources", ctx, request)
 	ret0, _ := ret[0].(*ecs.TagResourcesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // TagResources indicates an expected call of TagResources.
-func (mr *MockClientMockRecorder) TagResources(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) TagResources(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockClient)(nil).TagResources), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResources", reflect.TypeOf((*MockClient)(nil).TagResources), ctx, request)
 }
 
 // UnassociateEipAddress mocks base method.
-func (m *MockClient) UnassociateEipAddress(arg0 *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
+func (m *MockClient) UnassociateEipAddress(ctx context.Context, request *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnassociateEipAddress", arg0)
+	ret := m.ctrl.Call(m, "UnassociateEipAddress", ctx, request)
 	ret0, _ := ret[0].(*vpc.UnassociateEipAddressResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UnassociateEipAddress indicates an expected call of UnassociateEipAddress.
-func (mr *MockClientMockRecorder) UnassociateEipAddress(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) UnassociateEipAddress(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateEipAddress", reflect.TypeOf((*MockClient)(nil).UnassociateEipAddress), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateEipAddress", reflect.TypeOf((*MockClient)(nil).UnassociateEipAddress), ctx, request)
 }
 
 // UntagResources mocks base method.
-func (m *MockClient) UntagResources(arg0 *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
+func (m *MockClient) UntagResources(ctx context.Context, request *ecs.UntagResourcesRequest) (*ecs.UntagResourcesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UntagResources", arg0)
+	ret := m.ctrl.Call(m, "UntagResources", ctx, request)
 	ret0, _ := ret[0].(*ecs.UntagResourcesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UntagResources indicates an expected call of UntagResources.
-func (mr *MockClientMockRecorder) UntagResources(arg0 interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) UntagResources(ctx, request interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResources", reflect.TypeOf((*MockClient)(nil).UntagResources), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResources", reflect.TypeOf((*MockClient)(nil).UntagResources), ctx, request)
 }
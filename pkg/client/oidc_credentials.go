@@ -0,0 +1,26 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+// RRSA (OIDC-based RAM Roles for Service Accounts, i.e. authenticating with a projected
+// service-account token via sts:AssumeRoleWithOIDC) is not implemented here.
+//
+// auth.NewSignerWithCredential, which every NewClient call above goes through, dispatches on a
+// fixed set of concrete *credentials.XCredential types declared inside the vendored SDK's auth
+// package; there is no OIDC credential type in that set, and a credential type of our own would
+// never match it. Supporting RRSA would mean either patching the vendored SDK directly or
+// re-implementing credential dispatch and request signing ourselves outside it, neither of which
+// this tree takes on. If a future SDK vendor bump adds an OIDC credential type upstream, this
+// file is where the provider-side plumbing (token file path, role ARN and OIDC provider ARN
+// config, wired the same way as SetAmbientRAMRoleName) should go.
@@ -0,0 +1,75 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RAMPolicy mirrors the shape of an Alibaba Cloud RAM policy document, e.g. the one a customer
+// attaches to the RAM role or user the provider authenticates as.
+type RAMPolicy struct {
+	Version   string         `json:"Version"`
+	Statement []RAMStatement `json:"Statement"`
+}
+
+// RAMStatement is a single statement within a RAMPolicy.
+type RAMStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// GenerateMinimalRAMPolicy builds the minimal RAM policy document covering every Alibaba Cloud API
+// the provider calls, one statement per service (ecs, vpc, slb), by reflecting over the Client
+// interface rather than hand-maintaining an action list that would silently drift out of sync as
+// NewClient's wrapper methods are added to or removed. Resource is always "*", matching that none
+// of the APIs wrapped here support resource-level RAM authorization.
+func GenerateMinimalRAMPolicy() RAMPolicy {
+	actionsByService := map[string][]string{}
+
+	clientType := reflect.TypeOf((*Client)(nil)).Elem()
+	for i := 0; i < clientType.NumMethod(); i++ {
+		method := clientType.Method(i)
+		// Every Client method takes (ctx context.Context, request *<service>.XRequest). The request
+		// type's package name is the RAM action's service prefix, and its name with the "Request"
+		// suffix stripped is the exact API action Alibaba Cloud expects in a RAM policy - that's not
+		// always identical to the wrapper method name above it (e.g. AttachInstanceRAMRole wraps
+		// AttachInstanceRamRoleRequest), so it's read from the request type rather than the method.
+		requestType := method.Type.In(1).Elem()
+		service := requestType.PkgPath()[strings.LastIndex(requestType.PkgPath(), "/")+1:]
+		action := strings.TrimSuffix(requestType.Name(), "Request")
+		actionsByService[service] = append(actionsByService[service], service+":"+action)
+	}
+
+	services := make([]string, 0, len(actionsByService))
+	for service := range actionsByService {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+
+	policy := RAMPolicy{Version: "1"}
+	for _, service := range services {
+		actions := actionsByService[service]
+		sort.Strings(actions)
+		policy.Statement = append(policy.Statement, RAMStatement{
+			Effect:   "Allow",
+			Action:   actions,
+			Resource: "*",
+		})
+	}
+	return policy
+}
@@ -0,0 +1,120 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// errInvalidCABundle is returned by LoadCACertPool when caBundle contains no usable PEM
+// certificates.
+var errInvalidCABundle = errors.New("no certificates found in CA bundle")
+
+// SDKTransportConfig controls the HTTP transport used by every Alibaba Cloud SDK client NewClient
+// builds: per-request connect/read timeouts and the idle connection pool. The defaults behave
+// poorly across high-latency cross-region links, so the manager exposes them as flags instead of
+// hardcoding them.
+//
+// There is no KeepAlive setting here: the vendored SDK rebuilds its dialer from scratch on every
+// request (sdk.Client.setTimeout), always without a keep-alive interval, so a value configured on
+// the http.Transport we hand it would never survive past the first call.
+type SDKTransportConfig struct {
+	// ConnectTimeout bounds how long dialing the Alibaba Cloud API endpoint may take.
+	ConnectTimeout time.Duration
+
+	// ReadTimeout bounds how long a single API call may take once the connection is established.
+	ReadTimeout time.Duration
+
+	// MaxIdleConns is the maximum number of idle connections kept across all hosts.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout is how long an idle connection is kept open before being closed.
+	IdleConnTimeout time.Duration
+
+	// CACertPool, if non-nil, is trusted for API endpoint TLS verification in addition to (not
+	// instead of) the system root CAs, for environments where OpenAPI traffic passes through a
+	// TLS-inspecting proxy.
+	CACertPool *x509.CertPool
+}
+
+// DefaultSDKTransportConfig returns the transport settings used when the manager isn't given
+// overrides, chosen to match net/http's own defaults plus the Alibaba Cloud SDK's own connect and
+// read timeout defaults.
+func DefaultSDKTransportConfig() SDKTransportConfig {
+	return SDKTransportConfig{
+		ConnectTimeout:      5 * time.Second,
+		ReadTimeout:         10 * time.Second,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+	}
+}
+
+var (
+	sdkTransportConfigMutex sync.RWMutex
+	sdkTransportConfig      = DefaultSDKTransportConfig()
+)
+
+// SetSDKTransportConfig overrides the transport settings used by subsequently created clients. It
+// must be called, if at all, before any AlibabaCloudClientBuilderFunc is invoked, since it has no
+// effect on clients that already exist.
+func SetSDKTransportConfig(cfg SDKTransportConfig) {
+	sdkTransportConfigMutex.Lock()
+	defer sdkTransportConfigMutex.Unlock()
+	sdkTransportConfig = cfg
+}
+
+// getSDKTransportConfig returns the transport settings NewClient should use.
+func getSDKTransportConfig() SDKTransportConfig {
+	sdkTransportConfigMutex.RLock()
+	defer sdkTransportConfigMutex.RUnlock()
+	return sdkTransportConfig
+}
+
+// newSDKHTTPTransport builds the http.Transport NewClient installs on each SDK client's
+// sdk.Config, so the idle connection pool is shared across ecs/vpc/slb calls to the same host
+// instead of each client dialing fresh connections. ConnectTimeout is not set here: it is applied
+// per-SDK-client via SetConnectTimeout instead, since the vendored SDK overwrites DialContext with
+// its own dialer built from that value before every request anyway.
+func newSDKHTTPTransport(cfg SDKTransportConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	if cfg.CACertPool != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: cfg.CACertPool}
+	}
+	return transport
+}
+
+// LoadCACertPool returns a certificate pool containing the system root CAs plus the PEM-encoded
+// certificates in caBundle, for trusting a custom CA alongside the public Alibaba Cloud endpoints.
+func LoadCACertPool(caBundle []byte) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(caBundle) {
+		return nil, errInvalidCABundle
+	}
+	return pool, nil
+}
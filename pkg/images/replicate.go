@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
+)
+
+// CopyImageToRegion copies the image identified by sourceRegionID/imageID to
+// destinationRegionID, tags the copy with the cluster ID, and waits for it to become Available
+// there, so machinesets in the destination region can reference a single logical image without a
+// separate manual import in each region.
+//
+// sourceClient and destinationClient must each be scoped to sourceRegionID and
+// destinationRegionID respectively, rather than passing the same client for both: a client's
+// per-service endpoint is resolved once at construction (see NewClient), and a client built for
+// sourceRegionID keeps pointing at the source region's endpoint - rather than following
+// destinationRegionID - whenever an endpoint override or auto-resolved regional endpoint is
+// configured, which would make the post-copy availability poll below query the wrong region.
+func CopyImageToRegion(ctx context.Context, sourceRegionID, destinationRegionID, imageID, destinationImageName, clusterID string, sourceClient, destinationClient alibabacloudClient.Client) (string, error) {
+	copyImageRequest := ecs.CreateCopyImageRequest()
+	copyImageRequest.Scheme = "https"
+	copyImageRequest.RegionId = sourceRegionID
+	copyImageRequest.ImageId = imageID
+	copyImageRequest.DestinationRegionId = destinationRegionID
+	copyImageRequest.DestinationImageName = destinationImageName
+	copyImageRequest.Tag = &[]ecs.CopyImageTag{
+		{Key: "kubernetes.io/cluster/" + clusterID, Value: "owned"},
+	}
+
+	copyImageResponse, err := sourceClient.CopyImage(ctx, copyImageRequest)
+	if err != nil {
+		return "", fmt.Errorf("error copying image %s from region %s to region %s: %v", imageID, sourceRegionID, destinationRegionID, err)
+	}
+
+	destinationImageID := copyImageResponse.ImageId
+
+	if err := waitForImageAvailable(ctx, destinationClient, destinationRegionID, destinationImageID, importImageDefaultTimeout); err != nil {
+		return destinationImageID, err
+	}
+
+	return destinationImageID, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/client/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyImageToRegion(t *testing.T) {
+	cases := []struct {
+		name            string
+		setupSourceMock func(*mock.MockClient)
+		setupDestMock   func(*mock.MockClient)
+		expectError     bool
+		expectImage     string
+	}{
+		{
+			name: "copies against the source client and waits for availability against the destination client",
+			setupSourceMock: func(m *mock.MockClient) {
+				m.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(&ecs.CopyImageResponse{ImageId: "m-copied"}, nil)
+			},
+			setupDestMock: func(m *mock.MockClient) {
+				m.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ecs.DescribeImagesResponse{
+					Images: ecs.Images{Image: []ecs.Image{{ImageId: "m-copied", Status: imageStatusAvailable}}},
+				}, nil)
+			},
+			expectImage: "m-copied",
+		},
+		{
+			name: "returns an error when CopyImage fails, never touching the destination client",
+			setupSourceMock: func(m *mock.MockClient) {
+				m.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(nil, errors.New("copy failed"))
+			},
+			setupDestMock: func(m *mock.MockClient) {},
+			expectError:   true,
+		},
+		{
+			name: "returns the image ID and an error when DescribeImages fails while waiting",
+			setupSourceMock: func(m *mock.MockClient) {
+				m.EXPECT().CopyImage(gomock.Any(), gomock.Any()).Return(&ecs.CopyImageResponse{ImageId: "m-copied"}, nil)
+			},
+			setupDestMock: func(m *mock.MockClient) {
+				m.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe failed"))
+			},
+			expectError: true,
+			expectImage: "m-copied",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			// Separate mocks for the source and destination clients, rather than one shared
+			// mock, so a regression that calls CopyImage against the destination client or
+			// DescribeImages against the source client - the bug this split guards against -
+			// fails as an unexpected call instead of passing silently.
+			sourceClient := mock.NewMockClient(mockCtrl)
+			tc.setupSourceMock(sourceClient)
+			destinationClient := mock.NewMockClient(mockCtrl)
+			tc.setupDestMock(destinationClient)
+
+			imageID, err := CopyImageToRegion(context.Background(), "cn-hangzhou", "cn-shanghai", "m-source", "rhcos-copy", "cluster-a", sourceClient, destinationClient)
+
+			assert.Equal(t, tc.expectImage, imageID)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
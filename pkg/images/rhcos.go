@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package images provides helpers for getting an RHCOS image usable by machinesets into a
+// region, so day-2 machinesets in new regions don't require manual image plumbing. They're driven
+// by the cmd/import-rhcos-image and cmd/replicate-image binaries rather than a controller, since
+// an image import/copy is a one-off operational action with no cluster-visible object to
+// reconcile against.
+//
+// Uploading the RHCOS qcow2 to OSS is not implemented here, because no OSS SDK service is
+// vendored in this tree (only ecs, vpc and slb are present under
+// vendor/github.com/aliyun/alibaba-cloud-sdk-go/services). ImportRHCOSImage assumes the qcow2 has
+// already been uploaded to the given OSS bucket/object by some other means, and only drives the
+// ECS-side import, availability wait, and cluster tagging.
+package images
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	alibabacloudClient "github.com/openshift/cluster-api-provider-alibaba/pkg/client"
+)
+
+const (
+	// importImageDefaultTimeout is how long to wait for an imported image to become Available
+	importImageDefaultTimeout = 1800 * time.Second
+
+	// importImagePollInterval is how often to poll image status while waiting for availability
+	importImagePollInterval = 15 * time.Second
+
+	// imageStatusAvailable is the ecs.Image Status value reported once an image is usable
+	imageStatusAvailable = "Available"
+)
+
+// ImportRHCOSImage imports the RHCOS qcow2 already uploaded to ossBucket/ossObject as a custom
+// ECS image named imageName, waits for it to become Available, and tags it with the cluster ID so
+// it can be found and garbage collected the same way instances are.
+func ImportRHCOSImage(ctx context.Context, regionID, ossBucket, ossObject, imageName, architecture, clusterID string, client alibabacloudClient.Client) (string, error) {
+	importImageRequest := ecs.CreateImportImageRequest()
+	importImageRequest.Scheme = "https"
+	importImageRequest.RegionId = regionID
+	importImageRequest.ImageName = imageName
+	importImageRequest.Architecture = architecture
+	importImageRequest.OSType = "linux"
+	importImageRequest.Platform = "Others Linux"
+	importImageRequest.DiskDeviceMapping = &[]ecs.ImportImageDiskDeviceMapping{
+		{
+			OSSBucket: ossBucket,
+			OSSObject: ossObject,
+			Format:    "qcow2",
+		},
+	}
+
+	importImageResponse, err := client.ImportImage(ctx, importImageRequest)
+	if err != nil {
+		return "", fmt.Errorf("error importing image %s from oss://%s/%s: %v", imageName, ossBucket, ossObject, err)
+	}
+
+	imageID := importImageResponse.ImageId
+
+	if err := waitForImageAvailable(ctx, client, regionID, imageID, importImageDefaultTimeout); err != nil {
+		return imageID, err
+	}
+
+	tagResourcesRequest := ecs.CreateTagResourcesRequest()
+	tagResourcesRequest.Scheme = "https"
+	tagResourcesRequest.RegionId = regionID
+	tagResourcesRequest.ResourceId = &[]string{imageID}
+	tagResourcesRequest.ResourceType = "image"
+	tagResourcesRequest.Tag = &[]ecs.TagResourcesTag{
+		{Key: "kubernetes.io/cluster/" + clusterID, Value: "owned"},
+	}
+	if _, err := client.TagResources(ctx, tagResourcesRequest); err != nil {
+		return imageID, fmt.Errorf("error tagging image %s with cluster ID %s: %v", imageID, clusterID, err)
+	}
+
+	return imageID, nil
+}
+
+// waitForImageAvailable polls DescribeImages until imageID reaches the Available status, or
+// timeout elapses.
+func waitForImageAvailable(ctx context.Context, client alibabacloudClient.Client, regionID, imageID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		describeImagesRequest := ecs.CreateDescribeImagesRequest()
+		describeImagesRequest.Scheme = "https"
+		describeImagesRequest.RegionId = regionID
+		describeImagesRequest.ImageId = imageID
+
+		describeImagesResponse, err := client.DescribeImages(ctx, describeImagesRequest)
+		if err != nil {
+			return fmt.Errorf("error describing image %s: %v", imageID, err)
+		}
+
+		if describeImagesResponse != nil && len(describeImagesResponse.Images.Image) > 0 {
+			if status := describeImagesResponse.Images.Image[0].Status; status == imageStatusAvailable {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for image %s to become %s", imageID, imageStatusAvailable)
+		}
+
+		time.Sleep(importImagePollInterval)
+	}
+}
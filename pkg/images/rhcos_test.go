@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package images
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/openshift/cluster-api-provider-alibaba/pkg/client/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportRHCOSImage(t *testing.T) {
+	cases := []struct {
+		name        string
+		setupMock   func(*mock.MockClient)
+		expectError bool
+		expectImage string
+	}{
+		{
+			name: "imports, waits for availability and tags the image",
+			setupMock: func(m *mock.MockClient) {
+				m.EXPECT().ImportImage(gomock.Any(), gomock.Any()).Return(&ecs.ImportImageResponse{ImageId: "m-imported"}, nil)
+				m.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ecs.DescribeImagesResponse{
+					Images: ecs.Images{Image: []ecs.Image{{ImageId: "m-imported", Status: imageStatusAvailable}}},
+				}, nil)
+				m.EXPECT().TagResources(gomock.Any(), gomock.Any()).Return(&ecs.TagResourcesResponse{}, nil)
+			},
+			expectImage: "m-imported",
+		},
+		{
+			name: "returns an error when ImportImage fails",
+			setupMock: func(m *mock.MockClient) {
+				m.EXPECT().ImportImage(gomock.Any(), gomock.Any()).Return(nil, errors.New("import failed"))
+			},
+			expectError: true,
+		},
+		{
+			name: "returns the image ID and an error when DescribeImages fails while waiting",
+			setupMock: func(m *mock.MockClient) {
+				m.EXPECT().ImportImage(gomock.Any(), gomock.Any()).Return(&ecs.ImportImageResponse{ImageId: "m-imported"}, nil)
+				m.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(nil, errors.New("describe failed"))
+			},
+			expectError: true,
+			expectImage: "m-imported",
+		},
+		{
+			name: "returns the image ID and an error when tagging fails",
+			setupMock: func(m *mock.MockClient) {
+				m.EXPECT().ImportImage(gomock.Any(), gomock.Any()).Return(&ecs.ImportImageResponse{ImageId: "m-imported"}, nil)
+				m.EXPECT().DescribeImages(gomock.Any(), gomock.Any()).Return(&ecs.DescribeImagesResponse{
+					Images: ecs.Images{Image: []ecs.Image{{ImageId: "m-imported", Status: imageStatusAvailable}}},
+				}, nil)
+				m.EXPECT().TagResources(gomock.Any(), gomock.Any()).Return(nil, errors.New("tag failed"))
+			},
+			expectError: true,
+			expectImage: "m-imported",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockClient := mock.NewMockClient(mockCtrl)
+			tc.setupMock(mockClient)
+
+			imageID, err := ImportRHCOSImage(context.Background(), "cn-hangzhou", "my-bucket", "rhcos.qcow2", "rhcos", "x86_64", "cluster-a", mockClient)
+
+			assert.Equal(t, tc.expectImage, imageID)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks holds admission webhooks for this provider's API types. It's intentionally
+// thin: the webhook server, its TLS certs and the ValidatingWebhookConfiguration routing traffic
+// to it are provisioned by the cluster operator's manifests rather than anything in this repo.
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	configv1 "github.com/openshift/api/config/v1"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	alibabacloudproviderv1 "github.com/openshift/cluster-api-provider-alibaba/pkg/apis/alibabacloudprovider/v1"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// infrastructureResourceName is the name of the cluster-scoped Infrastructure object that carries
+// the cluster's home region.
+const infrastructureResourceName = "cluster"
+
+// MachineSetValidator warns, rather than rejects, when a MachineSet's providerSpec targets a
+// region other than the cluster's home region. Cross-region machinesets are allowed - the client
+// factory already builds a region-specific client for whatever RegionID a providerSpec sets - but
+// a stretched-compute topology like this isn't exercised by this provider's own tests and can
+// fail in surprising ways (e.g. a VPC or security group that only exists in the home region), so
+// operators are warned up front instead of left to discover that at machine creation time.
+type MachineSetValidator struct {
+	Client  runtimeclient.Client
+	decoder *admission.Decoder
+}
+
+// Handle implements admission.Handler.
+func (v *MachineSetValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	machineSet := &machinev1beta1.MachineSet{}
+	if err := v.decoder.Decode(req, machineSet); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	providerSpec, err := alibabacloudproviderv1.ProviderSpecFromRawExtension(machineSet.Spec.Template.Spec.ProviderSpec.Value)
+	if err != nil || providerSpec.RegionID == "" {
+		// A malformed or region-less providerSpec is some other validation's problem; this
+		// webhook only warns about a known region being the wrong one.
+		return admission.Allowed("")
+	}
+
+	homeRegion, err := v.homeRegion(ctx)
+	if err != nil {
+		klog.Errorf("failed to look up cluster home region for machineset %s/%s cross-region check: %v", machineSet.Namespace, machineSet.Name, err)
+		return admission.Allowed("")
+	}
+
+	if homeRegion != "" && providerSpec.RegionID != homeRegion {
+		return admission.Allowed("").WithWarnings(fmt.Sprintf(
+			"machineset %s/%s targets region %q, different from the cluster's home region %q; cross-region machinesets are allowed but aren't exercised by this provider's own tests",
+			machineSet.Namespace, machineSet.Name, providerSpec.RegionID, homeRegion))
+	}
+
+	return admission.Allowed("")
+}
+
+// homeRegion returns the cluster's home region from the cluster-scoped Infrastructure object's
+// AlibabaCloud platform status, or "" if it isn't set.
+func (v *MachineSetValidator) homeRegion(ctx context.Context) (string, error) {
+	infra := &configv1.Infrastructure{}
+	if err := v.Client.Get(ctx, runtimeclient.ObjectKey{Name: infrastructureResourceName}, infra); err != nil {
+		return "", err
+	}
+	if infra.Status.PlatformStatus == nil || infra.Status.PlatformStatus.AlibabaCloud == nil {
+		return "", nil
+	}
+	return infra.Status.PlatformStatus.AlibabaCloud.Region, nil
+}
+
+// InjectDecoder injects the decoder, as required by admission.DecoderInjector.
+func (v *MachineSetValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
@@ -0,0 +1,177 @@
+/*
+Copyright The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	machinev1 "github.com/openshift/api/machine/v1"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// fakeInfrastructureClient answers Get for the cluster-scoped Infrastructure object from a
+// canned result, and panics on anything else - homeRegion is the only thing MachineSetValidator
+// uses its Client for. Embedding the runtimeclient.Client interface satisfies the rest of it
+// without having to implement every method a fully-fledged fake would need.
+type fakeInfrastructureClient struct {
+	runtimeclient.Client
+
+	infra *configv1.Infrastructure
+	err   error
+}
+
+func (f *fakeInfrastructureClient) Get(ctx context.Context, key runtimeclient.ObjectKey, obj runtimeclient.Object) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.infra.DeepCopyInto(obj.(*configv1.Infrastructure))
+	return nil
+}
+
+func machineSetWithRegion(t *testing.T, regionID string) []byte {
+	t.Helper()
+
+	providerSpec := &machinev1.AlibabaCloudMachineProviderConfig{RegionID: regionID}
+	raw, err := json.Marshal(providerSpec)
+	assert.NoError(t, err)
+
+	machineSet := &machinev1beta1.MachineSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "machine.openshift.io/v1beta1", Kind: "MachineSet"},
+		ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "openshift-machine-api"},
+		Spec: machinev1beta1.MachineSetSpec{
+			Template: machinev1beta1.MachineTemplateSpec{
+				Spec: machinev1beta1.MachineSpec{
+					ProviderSpec: machinev1beta1.ProviderSpec{Value: &runtime.RawExtension{Raw: raw}},
+				},
+			},
+		},
+	}
+
+	encoded, err := json.Marshal(machineSet)
+	assert.NoError(t, err)
+	return encoded
+}
+
+func infrastructureWithRegion(regionID string) *configv1.Infrastructure {
+	infra := &configv1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: infrastructureResourceName}}
+	if regionID != "" {
+		infra.Status.PlatformStatus = &configv1.PlatformStatus{
+			AlibabaCloud: &configv1.AlibabaCloudPlatformStatus{Region: regionID},
+		}
+	}
+	return infra
+}
+
+func newDecoder(t *testing.T) *admission.Decoder {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	assert.NoError(t, machinev1beta1.AddToScheme(scheme))
+	assert.NoError(t, configv1.AddToScheme(scheme))
+
+	decoder, err := admission.NewDecoder(scheme)
+	assert.NoError(t, err)
+	return decoder
+}
+
+func TestMachineSetValidatorHandle(t *testing.T) {
+	cases := []struct {
+		name             string
+		rawMachineSet    []byte
+		infra            *configv1.Infrastructure
+		infraErr         error
+		expectAllowed    bool
+		expectWarnings   int
+		expectStatusCode int32
+	}{
+		{
+			name:             "decode error",
+			rawMachineSet:    []byte("{not valid json"),
+			expectAllowed:    false,
+			expectStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:          "missing providerSpec is someone else's validation to reject",
+			rawMachineSet: mustMarshalEmptyMachineSet(t),
+			expectAllowed: true,
+		},
+		{
+			name:          "matching region is allowed without warnings",
+			rawMachineSet: machineSetWithRegion(t, "cn-hangzhou"),
+			infra:         infrastructureWithRegion("cn-hangzhou"),
+			expectAllowed: true,
+		},
+		{
+			name:           "cross-region machineset is allowed with a warning",
+			rawMachineSet:  machineSetWithRegion(t, "cn-shanghai"),
+			infra:          infrastructureWithRegion("cn-hangzhou"),
+			expectAllowed:  true,
+			expectWarnings: 1,
+		},
+		{
+			name:          "infrastructure fetch error is allowed without blocking on a webhook-side failure",
+			rawMachineSet: machineSetWithRegion(t, "cn-hangzhou"),
+			infraErr:      errors.New("infrastructures.config.openshift.io \"cluster\" not found"),
+			expectAllowed: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			infra := tc.infra
+			if infra == nil {
+				infra = infrastructureWithRegion("")
+			}
+
+			validator := &MachineSetValidator{
+				Client: &fakeInfrastructureClient{infra: infra, err: tc.infraErr},
+			}
+			assert.NoError(t, validator.InjectDecoder(newDecoder(t)))
+
+			resp := validator.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Object: runtime.RawExtension{Raw: tc.rawMachineSet},
+				},
+			})
+
+			assert.Equal(t, tc.expectAllowed, resp.Allowed)
+			assert.Len(t, resp.Warnings, tc.expectWarnings)
+			if tc.expectStatusCode != 0 {
+				assert.NotNil(t, resp.Result)
+				assert.Equal(t, tc.expectStatusCode, resp.Result.Code)
+			}
+		})
+	}
+}
+
+func mustMarshalEmptyMachineSet(t *testing.T) []byte {
+	t.Helper()
+
+	encoded, err := json.Marshal(&machinev1beta1.MachineSet{
+		TypeMeta: metav1.TypeMeta{APIVersion: "machine.openshift.io/v1beta1", Kind: "MachineSet"},
+	})
+	assert.NoError(t, err)
+	return encoded
+}
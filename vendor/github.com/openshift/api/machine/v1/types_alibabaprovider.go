@@ -80,6 +80,14 @@ type AlibabaCloudMachineProviderConfig struct {
 	//The instance type of the instance.
 	InstanceType string `json:"instanceType"`
 
+	// InstanceID is the ID of an existing ECS instance to adopt instead of launching a new one,
+	// enabling BYO-node and disaster-recovery workflows where the instance was created out of
+	// band. When set, the actuator verifies the instance exists, corrects its cluster/machine
+	// tags, and manages its lifecycle from then on instead of calling RunInstances; the remaining
+	// instance-creation fields of this spec are ignored.
+	// +optional
+	InstanceID string `json:"instanceId,omitempty"`
+
 	// The ID of the vpc
 	// +optional
 	VpcID string `json:"vpcId,omitempty"`
@@ -91,7 +99,14 @@ type AlibabaCloudMachineProviderConfig struct {
 	ZoneID string `json:"zoneId"`
 
 	// The ID of the image used to create the instance.
-	ImageID string `json:"imageId"`
+	// +optional
+	ImageID string `json:"imageId,omitempty"`
+
+	// ImageFilter selects the image used to create the instance by name, family or owner instead
+	// of a literal ImageID, picking the most recently created match. Only used when ImageID is
+	// empty.
+	// +optional
+	ImageFilter *AlibabaImageFilter `json:"imageFilter,omitempty"`
 
 	// DataDisks holds information regarding the extra disks attached to the instance
 	// +optional
@@ -103,10 +118,71 @@ type AlibabaCloudMachineProviderConfig struct {
 	// https://www.alibabacloud.com/help/doc-detail/101348.htm?spm=a2c63.p38356.879954.48.78f0199aX3dfIE
 	SecurityGroups []AlibabaResourceReference `json:"securityGroups,omitempty"`
 
+	// ManagedSecurityGroup, when true and SecurityGroups is empty, makes the platform create and
+	// maintain a cluster-owned security group with default rules instead of failing machine
+	// creation. The security group is tagged with kubernetes.io/cluster/<clusterID> and is garbage
+	// collected with the cluster.
+	// +optional
+	ManagedSecurityGroup bool `json:"managedSecurityGroup,omitempty"`
+
+	// DeletionProtection, when true, prevents the instance from being deleted through the ECS
+	// console or API. The platform clears this automatically before deleting the machine, so
+	// machine-api initiated deletions are unaffected.
+	// +optional
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
 	// Bandwidth describes the internet bandwidth strategy for the instance
 	// +optional
 	Bandwidth BandwidthProperties `json:"bandwidth,omitempty"`
 
+	// LoadBalancerServerGroups lists the ALB and NLB server groups the instance should be
+	// registered to, for clusters fronted by the newer LB families.
+	// +optional
+	LoadBalancerServerGroups []AlibabaCloudLoadBalancerServerGroupAttachment `json:"loadBalancerServerGroups,omitempty"`
+
+	// AutoSnapshotPolicyID is the ID of an auto snapshot policy to apply to the instance's system
+	// and data disks once it is Running, so the machine's disks get scheduled backups.
+	// Empty value means no opinion, and no auto snapshot policy is applied.
+	// +optional
+	AutoSnapshotPolicyID string `json:"autoSnapshotPolicyId,omitempty"`
+
+	// StorageSetID is the ID of a dedicated block storage cluster the instance's disks should be
+	// created on, for regulated customers that must keep their disks off shared storage clusters.
+	// Empty value means no opinion, and the instance's disks are created on shared storage clusters.
+	// +optional
+	StorageSetID string `json:"storageSetId,omitempty"`
+
+	// StorageSetPartitionNumber is the number of partitions to evenly distribute instances across
+	// within the dedicated block storage cluster identified by StorageSetID. Only meaningful when
+	// StorageSetID is set.
+	// Empty value means no opinion, and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `1`.
+	// +optional
+	StorageSetPartitionNumber int64 `json:"storageSetPartitionNumber,omitempty"`
+
+	// SerialConsoleEnabled requests that the instance serial console be enabled at creation, for
+	// debugging kernel issues on nodes that never come up.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `false`.
+	// +optional
+	SerialConsoleEnabled *bool `json:"serialConsoleEnabled,omitempty"`
+
+	// InstanceReadyTimeout is the number of seconds to keep waiting for a newly created instance
+	// to reach the Running state before giving up on the machine. Bare Metal instances boot
+	// directly on dedicated hardware and can need substantially longer than the default.
+	// Empty value means no opinion, and the platform chooses a default, which is subject to
+	// change over time. Currently the default is `900`.
+	// +optional
+	InstanceReadyTimeout *int64 `json:"instanceReadyTimeout,omitempty"`
+
+	// InstanceDeleteTimeout is the number of seconds to keep waiting for an instance to reach the
+	// Stopped state before deleting it. Spot instances can be slower to stop than on-demand
+	// instances under capacity pressure.
+	// Empty value means no opinion, and the platform chooses a default, which is subject to
+	// change over time. Currently the default is `900`.
+	// +optional
+	InstanceDeleteTimeout *int64 `json:"instanceDeleteTimeout,omitempty"`
+
 	// SystemDisk holds the properties regarding the system disk for the instance
 	// +optional
 	SystemDisk SystemDiskProperties `json:"systemDisk,omitempty"`
@@ -134,6 +210,27 @@ type AlibabaCloudMachineProviderConfig struct {
 	// +optional
 	Tenancy InstanceTenancy `json:"tenancy,omitempty"`
 
+	// Subscription configures the instance to be billed as a PrePaid (subscription) resource
+	// instead of the default PostPaid (pay-as-you-go) billing.
+	// +optional
+	Subscription *AlibabaSubscription `json:"subscription,omitempty"`
+
+	// StoppedMode controls whether a stopped PostPaid instance keeps accruing compute charges.
+	// Valid values: KeepCharging, StopCharging.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `KeepCharging`.
+	// +optional
+	StoppedMode string `json:"stoppedMode,omitempty"`
+
+	// StoppedInstanceExists controls whether a Stopped instance counts as existing for the
+	// purposes of the machine actuator's Exists check. When false, a stopped instance is treated
+	// as not existing and the machine is recreated, instead of left pointing at an instance an
+	// operator intentionally stopped outside of the normal power-state reconciliation.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is true.
+	// +optional
+	StoppedInstanceExists *bool `json:"stoppedInstanceExists,omitempty"`
+
 	// UserDataSecret contains a local reference to a secret that contains the
 	// UserData to apply to the instance
 	// +optional
@@ -147,16 +244,262 @@ type AlibabaCloudMachineProviderConfig struct {
 	// Tags are the set of metadata to add to an instance.
 	// +optional
 	Tags []Tag `json:"tag,omitempty"`
+
+	// EIP describes the Elastic IP Address to allocate and bind to the instance.
+	// When unset, no EIP is allocated for the machine.
+	// +optional
+	EIP *EIPProperties `json:"eip,omitempty"`
+
+	// NetworkInterfaces holds the secondary Elastic Network Interfaces to attach to the instance at
+	// creation time, in addition to the primary ENI derived from VSwitch and SecurityGroups. This is
+	// used for multus/Terway setups that require additional ENIs per machine.
+	// +optional
+	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
+
+	// Ipv6AddressCount is the number of IPv6 addresses to randomly assign to the primary network
+	// interface from the vswitch's IPv6 CIDR block. Mutually exclusive with Ipv6Addresses.
+	// +optional
+	Ipv6AddressCount int64 `json:"ipv6AddressCount,omitempty"`
+
+	// Ipv6Addresses is the list of explicit IPv6 addresses to assign to the primary network interface.
+	// Mutually exclusive with Ipv6AddressCount.
+	// +optional
+	Ipv6Addresses []string `json:"ipv6Addresses,omitempty"`
+
+	// CpuOptions allows customizing the CPU topology of the instance, for example to disable
+	// hyper-threading for licensing or latency-sensitive workloads.
+	// +optional
+	CpuOptions CpuOptionsProperties `json:"cpuOptions,omitempty"`
+
+	// LaunchTemplate references an existing ECS launch template to source instance configuration
+	// from. Fields managed by machine-api, such as tags, user data and the instance name, take
+	// precedence over the values stored in the launch template.
+	// +optional
+	LaunchTemplate *AlibabaLaunchTemplateReference `json:"launchTemplate,omitempty"`
+
+	// KeyPairName is the name of an existing SSH key pair to associate with the instance. On
+	// Windows instances this key pair is used to decrypt the administrator password instead.
+	// +optional
+	KeyPairName string `json:"keyPairName,omitempty"`
+
+	// PasswordSecret is a reference to a secret with the key "password" containing the login
+	// password to set on the instance. This is commonly used for Windows instances, which require
+	// a password rather than an SSH key pair to log in.
+	// +optional
+	PasswordSecret *corev1.LocalObjectReference `json:"passwordSecret,omitempty"`
+
+	// MetadataOptions controls access to the instance metadata service (IMDS).
+	// +optional
+	MetadataOptions MetadataOptionsProperties `json:"metadataOptions,omitempty"`
+
+	// SecurityEnhancementStrategy controls whether the Cloud Security Center (Aegis) agent is
+	// installed on the instance.
+	// Valid values: Active, Deactive.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `Active`.
+	// +optional
+	SecurityEnhancementStrategy string `json:"securityEnhancementStrategy,omitempty"`
+
+	// TrustedSystemMode enables a virtual Trusted Platform Module (vTPM) on the instance for
+	// measured/trusted boot.
+	// Valid values: TPM.
+	// Empty value means no opinion, and no trusted system mode is configured.
+	// +optional
+	TrustedSystemMode string `json:"trustedSystemMode,omitempty"`
+
+	// HostNameFormat is a template for the instance host name, allowing it to differ from the
+	// Machine name, for example to append a corporate domain suffix required for node
+	// registration. A single "%s" verb, if present, is replaced with the Machine name; otherwise
+	// HostNameFormat is appended to the Machine name as a suffix.
+	// Empty value means no opinion, and the Machine name is used verbatim.
+	// +optional
+	HostNameFormat string `json:"hostNameFormat,omitempty"`
+
+	// HpcClusterID is the ID of the Super Computing Cluster (SCC) to join. Required by RDMA/SCC
+	// instance families to reach the cluster's low-latency network.
+	// +optional
+	HpcClusterID string `json:"hpcClusterId,omitempty"`
+
+	// NetworkInterfaceTrafficMode selects the traffic mode of the primary network interface.
+	// Set to HighPerformance to enable eRDMA for low-latency AI/HPC workloads; only instance
+	// types with eRDMA support accept this value.
+	// Valid values: Standard, HighPerformance.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `Standard`.
+	// +optional
+	NetworkInterfaceTrafficMode string `json:"networkInterfaceTrafficMode,omitempty"`
+
+	// PrivateIPAddress pins the primary network interface to a specific private IP address,
+	// useful for re-creating control-plane machines with a stable IP. The address must fall
+	// inside the target VSwitch's CIDR block and must not already be in use.
+	// Empty value means no opinion, and the platform assigns an available address automatically.
+	// +optional
+	PrivateIPAddress string `json:"privateIPAddress,omitempty"`
+
+	// SecondaryPrivateIPCount is the number of additional private IP addresses to assign to the
+	// primary network interface at creation, for CNIs that consume ENI secondary IPs directly
+	// (for example Terway in exclusive ENI mode).
+	// Empty value means no opinion, and no secondary private IP addresses are assigned.
+	// +optional
+	SecondaryPrivateIPCount int64 `json:"secondaryPrivateIPCount,omitempty"`
+
+	// NetworkInterfaceQueueNumber is the number of queues to allocate to the primary network
+	// interface, for high-PPS workloads that benefit from multi-queue RSS. The value must not
+	// exceed the instance type's maximum queue count for its primary ENI.
+	// Empty value means no opinion, and the platform chooses a default, which is subject to change
+	// over time.
+	// +optional
+	NetworkInterfaceQueueNumber int64 `json:"networkInterfaceQueueNumber,omitempty"`
+
+	// SourceDestCheck disables source/destination checking on the primary network interface when
+	// set to false, required for nodes acting as NAT or egress gateways that forward traffic not
+	// addressed to themselves.
+	// Empty value means no opinion, and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `true`.
+	// +optional
+	SourceDestCheck *bool `json:"sourceDestCheck,omitempty"`
+}
+
+// MetadataOptionsProperties controls access to the ECS instance metadata service (IMDS).
+type MetadataOptionsProperties struct {
+	// HTTPTokens controls whether IMDSv1 (unauthenticated) requests are accepted.
+	// Valid values: optional, required.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `optional`.
+	// +optional
+	HTTPTokens string `json:"httpTokens,omitempty"`
+
+	// HTTPEndpoint enables or disables the instance metadata service.
+	// Valid values: enabled, disabled.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `enabled`.
+	// +optional
+	HTTPEndpoint string `json:"httpEndpoint,omitempty"`
+
+	// HTTPPutResponseHopLimit is the maximum number of network hops the metadata token is
+	// allowed to travel, capping how far the metadata service can be reached from the instance.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time.
+	// +optional
+	HTTPPutResponseHopLimit int64 `json:"httpPutResponseHopLimit,omitempty"`
+
+	// InstanceMetadataTags controls whether the instance and custom image tags of the instance
+	// are exposed through the instance metadata service, letting workloads read them without
+	// calling the ECS API.
+	// Valid values: enabled, disabled.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `disabled`.
+	// +optional
+	InstanceMetadataTags string `json:"instanceMetadataTags,omitempty"`
+}
+
+// AlibabaImageFilter selects an image by name, family and/or owner instead of a literal ID.
+type AlibabaImageFilter struct {
+	// Name is the exact ImageName to match.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Family is the ImageFamily to match, e.g. "acs:ubuntu_20_04_x64".
+	// +optional
+	Family string `json:"family,omitempty"`
+
+	// OwnerAlias restricts the search to images owned by the given alias.
+	// Valid values: system, self, others, marketplace.
+	// +optional
+	OwnerAlias string `json:"ownerAlias,omitempty"`
+
+	// Architecture restricts the search to images built for the given architecture,
+	// e.g. "x86_64" or "arm64".
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+}
+
+// AlibabaLaunchTemplateReference identifies an ECS launch template and, optionally, a specific
+// version of it. Only one of ID or Name may be specified.
+type AlibabaLaunchTemplateReference struct {
+	// ID of the launch template.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Name of the launch template.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Version of the launch template to use.
+	// Empty value means no opinion and the platform uses the launch template's default version.
+	// +optional
+	Version int64 `json:"version,omitempty"`
+}
+
+// CpuOptionsProperties contains the CPU topology options for an instance.
+type CpuOptionsProperties struct {
+	// Core is the number of physical CPU cores to assign to the instance.
+	// Empty value means no opinion and the platform uses the instance type's default core count.
+	// +optional
+	Core int64 `json:"core,omitempty"`
+
+	// ThreadsPerCore is the number of threads per physical CPU core. Set to 1 to disable
+	// hyper-threading.
+	// Empty value means no opinion and the platform uses the instance type's default.
+	// +optional
+	ThreadsPerCore int64 `json:"threadsPerCore,omitempty"`
+}
+
+// NetworkInterface describes a secondary Elastic Network Interface to attach to the instance.
+type NetworkInterface struct {
+	// VSwitch is a reference to the vswitch the network interface should be created in.
+	// +optional
+	VSwitch AlibabaResourceReference `json:"vSwitch,omitempty"`
+
+	// SecurityGroups is the list of security group references to assign to the network interface.
+	// +optional
+	SecurityGroups []AlibabaResourceReference `json:"securityGroups,omitempty"`
+
+	// PrimaryIPAddress pins the primary private IP address of the network interface.
+	// Empty value means the platform chooses an address from the vswitch CIDR.
+	// +optional
+	PrimaryIPAddress string `json:"primaryIpAddress,omitempty"`
+
+	// QueueNumber is the number of queues supported by the network interface.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// +optional
+	QueueNumber int64 `json:"queueNumber,omitempty"`
+}
+
+// EIPProperties contains the information required to allocate and associate an Elastic IP Address with an instance.
+type EIPProperties struct {
+	// Bandwidth is the peak bandwidth of the EIP. Unit: Mbit/s.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is `5`.
+	// +optional
+	Bandwidth int64 `json:"bandwidth,omitempty"`
+
+	// ISP is the line type of the EIP.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is `BGP`.
+	// +optional
+	ISP string `json:"isp,omitempty"`
+
+	// InstanceChargeType is the billing method of the EIP.
+	// Valid values: PrePaid, PostPaid.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is `PostPaid`.
+	// +optional
+	InstanceChargeType string `json:"instanceChargeType,omitempty"`
 }
 
-// ResourceTagReference is a reference to a specific AlibabaCloud resource by ID, or tags.
-// Only one of ID or Tags may be specified. Specifying more than one will result in
+// ResourceTagReference is a reference to a specific AlibabaCloud resource by ID, name, or tags.
+// Only one of ID, Name or Tags may be specified. Specifying more than one will result in
 // a validation error.
 type AlibabaResourceReference struct {
 	// ID of resource
 	// +optional
 	ID string `json:"id,omitempty"`
 
+	// Name of resource
+	// +optional
+	Name string `json:"name,omitempty"`
+
 	// Tags is a set of metadata based upon ECS object tags used to identify a resource
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -189,6 +532,50 @@ type AlibabaCloudMachineProviderStatus struct {
 	// +optional
 	InstanceState *string `json:"instanceState,omitempty"`
 
+	// EIPAllocationID is the allocation ID of the Elastic IP Address bound to this machine's instance,
+	// if one was requested in the provider spec. It is used to release the EIP on machine deletion.
+	// +optional
+	EIPAllocationID *string `json:"eipAllocationId,omitempty"`
+
+	// Zone is the availability zone the instance was actually launched in.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// InstanceType is the instance type of the alibabacloud instance for this machine
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// ImageID is the ID of the image the instance was actually launched from
+	// +optional
+	ImageID *string `json:"imageId,omitempty"`
+
+	// PrivateIP is the primary private IP address of the instance
+	// +optional
+	PrivateIP *string `json:"privateIp,omitempty"`
+
+	// PublicIP is the primary public IP address of the instance, if one is assigned
+	// +optional
+	PublicIP *string `json:"publicIp,omitempty"`
+
+	// EIP is the Elastic IP Address bound to the instance, if one is assigned
+	// +optional
+	EIP *string `json:"eip,omitempty"`
+
+	// IPv6Addresses are the IPv6 addresses assigned to the instance's network interfaces
+	// +optional
+	IPv6Addresses []string `json:"ipv6Addresses,omitempty"`
+
+	// FailedCreateAttempts is the number of consecutive RunInstances failures observed for this
+	// machine since its last successful create. It drives exponential backoff between create
+	// retries and is reset to zero on a successful create.
+	// +optional
+	FailedCreateAttempts int32 `json:"failedCreateAttempts,omitempty"`
+
+	// LastCreateFailureTime is when the most recent RunInstances failure was observed, used
+	// together with FailedCreateAttempts to compute how long to wait before retrying.
+	// +optional
+	LastCreateFailureTime *metav1.Time `json:"lastCreateFailureTime,omitempty"`
+
 	// Conditions is a set of conditions associated with the Machine to indicate
 	// errors or other status
 	// +optional
@@ -237,10 +624,25 @@ type SystemDiskProperties struct {
 	// Currently the default is `40` or the size of the image depending on whichever is greater.
 	// +optional
 	Size int64 `json:"size,omitempty"`
+
+	// DeleteWithInstance controls whether the system disk is released when the instance is
+	// deleted. Set to false to preserve the root disk for forensics after a machine is deleted,
+	// mirroring DataDisk.DiskPreservation for data disks.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `true`.
+	// +optional
+	DeleteWithInstance *bool `json:"deleteWithInstance,omitempty"`
 }
 
 // DataDisk contains the information regarding the datadisk attached to an instance
 type DataDiskProperties struct {
+	// DiskID is the ID of an existing disk to attach to the instance instead of creating a new
+	// one. When set, the remaining fields of this entry are ignored: the disk is attached after
+	// the instance reaches the Running state via AttachDisk, and detached (without being deleted)
+	// on machine deletion via DetachDisk, enabling stateful node patterns.
+	// +optional
+	DiskID string `name:"diskId,omitempty"`
+
 	// Name is the name of data disk N. If the name is specified the name must be 2 to 128 characters in length. It must start with a letter and cannot start with http:// or https://. It can contain letters, digits, colons (:), underscores (_), and hyphens (-).
 	//
 	// Empty value means the platform chooses a default, which is subject to change over time.
@@ -339,4 +741,50 @@ type BandwidthProperties struct {
 	// Currently the default is `0`
 	// +optional
 	InternetMaxBandwidthOut int64 `json:"internetMaxBandwidthOut,omitempty"`
+
+	// InternetChargeType is the billing method for the automatically allocated public IP address.
+	// Valid values: PayByBandwidth, PayByTraffic.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `PayByBandwidth`.
+	// +optional
+	InternetChargeType string `json:"internetChargeType,omitempty"`
+}
+
+// AlibabaCloudLoadBalancerServerGroupAttachment describes an ALB or NLB server group the
+// instance should be registered to.
+type AlibabaCloudLoadBalancerServerGroupAttachment struct {
+	// Type selects the server group kind.
+	// Valid values: ALB, NLB.
+	Type string `json:"type"`
+
+	// ServerGroupID is the ID of the ALB or NLB server group to attach the instance to.
+	ServerGroupID string `json:"serverGroupId"`
+
+	// Port is the backend port to register the instance on.
+	Port int64 `json:"port"`
+
+	// Weight is the backend server weight, from 0 to 100. A weight of 0 disables traffic to the
+	// instance without removing it from the server group.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `100`.
+	// +optional
+	Weight int64 `json:"weight,omitempty"`
+}
+
+// AlibabaSubscription configures PrePaid (subscription) billing for an instance.
+type AlibabaSubscription struct {
+	// Period is the subscription duration, in units of PeriodUnit.
+	Period int64 `json:"period"`
+
+	// PeriodUnit is the unit used for Period.
+	// Valid values: Month, Week.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change
+	// over time. Currently the default is `Month`.
+	// +optional
+	PeriodUnit string `json:"periodUnit,omitempty"`
+
+	// AutoRenew, when true, automatically renews the subscription at expiry instead of letting
+	// the instance expire.
+	// +optional
+	AutoRenew bool `json:"autoRenew,omitempty"`
 }